@@ -7,12 +7,11 @@ import (
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
+
+	"github.com/onexstack/fastgo/internal/pkg/known"
 )
 
 const (
-	// defaultHomeDir 定义放置 fastgo 服务配置的默认目录.
-	defaultHomeDir = ".fastgo"
-
 	// defaultConfigName 指定 fastgo 服务的默认配置文件名.
 	defaultConfigName = "fg-apiserver.yaml"
 )
@@ -59,7 +58,7 @@ func searchDirs() []string {
 	cobra.CheckErr(err)
 
 	return []string{
-		filepath.Join(home, defaultHomeDir), ".",
+		filepath.Join(home, known.HomeDir), ".",
 	}
 }
 
@@ -67,5 +66,5 @@ func filePath() string {
 	home, err := os.UserHomeDir()
 	cobra.CheckErr(err)
 
-	return filepath.Join(home, defaultHomeDir, defaultConfigName)
+	return filepath.Join(home, known.HomeDir, defaultConfigName)
 }
@@ -0,0 +1,182 @@
+package app
+
+import (
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/gosuri/uitable"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/onexstack/fastgo/cmd/fg-apiserver/app/options"
+	"github.com/onexstack/fastgo/internal/apiserver/migration"
+	"github.com/onexstack/fastgo/internal/pkg/log"
+)
+
+// newMigrateCommand 创建管理数据库表结构迁移的 migrate 子命令.
+func newMigrateCommand(opts *options.ServerOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate",
+		Short: "Manage database schema migrations",
+		Long:  `Apply, roll back, inspect and create versioned database schema migrations.`,
+		Args:  cobra.NoArgs,
+	}
+
+	cmd.AddCommand(
+		newMigrateUpCommand(opts),
+		newMigrateDownCommand(opts),
+		newMigrateStatusCommand(opts),
+		newMigrateCreateCommand(),
+	)
+
+	return cmd
+}
+
+func newMigrateUpCommand(opts *options.ServerOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "up",
+		Short: "Apply all pending migrations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			migrator, err := newMigrator(opts)
+			if err != nil {
+				return err
+			}
+
+			applied, err := migrator.Up(cmd.Context())
+			for _, m := range applied {
+				fmt.Fprintf(cmd.OutOrStdout(), "applied %06d_%s\n", m.Version, m.Name)
+			}
+			if err != nil {
+				return err
+			}
+
+			if len(applied) == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "no pending migrations")
+			}
+
+			return nil
+		},
+	}
+}
+
+func newMigrateDownCommand(opts *options.ServerOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "down [N]",
+		Short: "Roll back the last N applied migrations (default 1)",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			n := 1
+			if len(args) == 1 {
+				var err error
+				if n, err = strconv.Atoi(args[0]); err != nil || n <= 0 {
+					return fmt.Errorf("invalid number of migrations to roll back: %s", args[0])
+				}
+			}
+
+			migrator, err := newMigrator(opts)
+			if err != nil {
+				return err
+			}
+
+			rolledBack, err := migrator.Down(cmd.Context(), n)
+			for _, m := range rolledBack {
+				fmt.Fprintf(cmd.OutOrStdout(), "rolled back %06d_%s\n", m.Version, m.Name)
+			}
+			if err != nil {
+				return err
+			}
+
+			if len(rolledBack) == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "no applied migrations")
+			}
+
+			return nil
+		},
+	}
+}
+
+func newMigrateStatusCommand(opts *options.ServerOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "status",
+		Short: "Show the status of all migrations",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			migrator, err := newMigrator(opts)
+			if err != nil {
+				return err
+			}
+
+			statuses, err := migrator.Status(cmd.Context())
+			if err != nil {
+				return err
+			}
+
+			table := uitable.New()
+			table.AddRow("VERSION", "NAME", "STATUS", "APPLIED AT")
+			for _, st := range statuses {
+				status, appliedAt := "pending", "-"
+				if st.Applied {
+					status, appliedAt = "applied", st.AppliedAt.Format(time.DateTime)
+				}
+				table.AddRow(fmt.Sprintf("%06d", st.Version), st.Name, status, appliedAt)
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), table)
+
+			return nil
+		},
+	}
+}
+
+func newMigrateCreateCommand() *cobra.Command {
+	var dir string
+
+	cmd := &cobra.Command{
+		Use:   "create <name>",
+		Short: "Create a new pair of up/down migration files for every dialect",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			files, err := migration.Create(dir, args[0])
+			for _, file := range files {
+				fmt.Fprintf(cmd.OutOrStdout(), "created %s\n", file)
+			}
+
+			return err
+		},
+	}
+
+	cmd.Flags().StringVar(&dir, "dir", migration.DefaultDir, "Directory in which the migration files are created.")
+
+	return cmd
+}
+
+// newMigrator 根据配置连接数据库，并创建 Migrator 实例.
+func newMigrator(opts *options.ServerOptions) (*migration.Migrator, error) {
+	// 将 viper 中的配置解析到选项 opts 变量中.
+	if err := viper.Unmarshal(opts); err != nil {
+		return nil, err
+	}
+
+	// 验证选项 opts 变量.
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	// 迁移命令执行时间很短，只需要初始化日志输出
+	if err := log.Init(opts.LogOptions); err != nil {
+		return nil, err
+	}
+
+	cfg, err := opts.Config()
+	if err != nil {
+		return nil, err
+	}
+
+	db, err := cfg.NewDB()
+	if err != nil {
+		return nil, err
+	}
+
+	return migration.New(db)
+}
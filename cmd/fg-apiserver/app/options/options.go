@@ -3,26 +3,117 @@ package options
 import (
 	"fmt"
 	"net"
+	"slices"
 	"strconv"
 
 	"github.com/onexstack/fastgo/internal/apiserver"
+	"github.com/onexstack/fastgo/internal/pkg/known"
 	genericoptions "github.com/onexstack/fastgo/pkg/options"
 )
 
 type ServerOptions struct {
-	MySQLOptions *genericoptions.MySQLOptions `json:"mysql" mapstructure:"mysql"`
-	Addr         string                       `json:"addr" mapstructure:"addr"`
+	// Mode 是服务的运行模式，可选值为 development、production.
+	Mode              string                            `json:"mode" mapstructure:"mode"`
+	DBOptions         *genericoptions.DBOptions         `json:"db" mapstructure:"db"`
+	MySQLOptions      *genericoptions.MySQLOptions      `json:"mysql" mapstructure:"mysql"`
+	SQLiteOptions     *genericoptions.SQLiteOptions     `json:"sqlite" mapstructure:"sqlite"`
+	JWTOptions        *genericoptions.JWTOptions        `json:"jwt" mapstructure:"jwt"`
+	UserOptions       *genericoptions.UserOptions       `json:"user" mapstructure:"user"`
+	PostOptions       *genericoptions.PostOptions       `json:"post" mapstructure:"post"`
+	TrashOptions      *genericoptions.TrashOptions      `json:"trash" mapstructure:"trash"`
+	PaginationOptions *genericoptions.PaginationOptions `json:"pagination" mapstructure:"pagination"`
+	SearchOptions     *genericoptions.SearchOptions     `json:"search" mapstructure:"search"`
+	GRPCOptions       *genericoptions.GRPCOptions       `json:"grpc" mapstructure:"grpc"`
+	MetricsOptions    *genericoptions.MetricsOptions    `json:"metrics" mapstructure:"metrics"`
+	TracingOptions    *genericoptions.TracingOptions    `json:"tracing" mapstructure:"tracing"`
+	LogOptions        *genericoptions.LogOptions        `json:"log" mapstructure:"log"`
+	Addr              string                            `json:"addr" mapstructure:"addr"`
 }
 
 func NewServerOptions() *ServerOptions {
 	return &ServerOptions{
-		MySQLOptions: genericoptions.NewMySQLOptions(),
-		Addr:         "0.0.0.0:6666",
+		Mode:              known.ModeProduction,
+		DBOptions:         genericoptions.NewDBOptions(),
+		MySQLOptions:      genericoptions.NewMySQLOptions(),
+		SQLiteOptions:     genericoptions.NewSQLiteOptions(),
+		JWTOptions:        genericoptions.NewJWTOptions(),
+		UserOptions:       genericoptions.NewUserOptions(),
+		PostOptions:       genericoptions.NewPostOptions(),
+		TrashOptions:      genericoptions.NewTrashOptions(),
+		PaginationOptions: genericoptions.NewPaginationOptions(),
+		SearchOptions:     genericoptions.NewSearchOptions(),
+		GRPCOptions:       genericoptions.NewGRPCOptions(),
+		MetricsOptions:    genericoptions.NewMetricsOptions(),
+		TracingOptions:    genericoptions.NewTracingOptions(),
+		LogOptions:        genericoptions.NewLogOptions(),
+		Addr:              "0.0.0.0:6666",
 	}
 }
 
 func (o *ServerOptions) Validate() error {
-	if err := o.MySQLOptions.Validate(); err != nil {
+	modes := []string{known.ModeDevelopment, known.ModeProduction}
+	if !slices.Contains(modes, o.Mode) {
+		return fmt.Errorf("invalid mode '%s', must be one of %v", o.Mode, modes)
+	}
+
+	if err := o.DBOptions.Validate(); err != nil {
+		return err
+	}
+
+	// 只校验所选存储后端的配置，内存数据库无需额外配置
+	switch o.DBOptions.Driver {
+	case genericoptions.DriverMySQL:
+		if err := o.MySQLOptions.Validate(); err != nil {
+			return err
+		}
+	case genericoptions.DriverSQLite:
+		if err := o.SQLiteOptions.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if err := o.JWTOptions.Validate(); err != nil {
+		return err
+	}
+
+	if err := o.UserOptions.Validate(); err != nil {
+		return err
+	}
+
+	if err := o.PostOptions.Validate(); err != nil {
+		return err
+	}
+
+	if err := o.TrashOptions.Validate(); err != nil {
+		return err
+	}
+
+	if err := o.PaginationOptions.Validate(); err != nil {
+		return err
+	}
+
+	if err := o.SearchOptions.Validate(); err != nil {
+		return err
+	}
+
+	// MySQL 全文检索直接查询 post 表的 FULLTEXT 索引
+	if o.SearchOptions.Driver == genericoptions.SearchDriverMySQL && o.DBOptions.Driver != genericoptions.DriverMySQL {
+		return fmt.Errorf("search.driver '%s' requires db.driver '%s'", o.SearchOptions.Driver, genericoptions.DriverMySQL)
+	}
+
+	if err := o.GRPCOptions.Validate(); err != nil {
+		return err
+	}
+
+	if err := o.MetricsOptions.Validate(); err != nil {
+		return err
+	}
+
+	if err := o.TracingOptions.Validate(); err != nil {
+		return err
+	}
+
+	if err := o.LogOptions.Validate(); err != nil {
 		return err
 	}
 
@@ -45,12 +136,34 @@ func (o *ServerOptions) Validate() error {
 		return fmt.Errorf("invalid server port: %d", port)
 	}
 
+	// REST API 和 gRPC 服务分别监听不同的端口
+	if o.GRPCOptions.Addr == o.Addr {
+		return fmt.Errorf("grpc.addr must be different from addr '%s'", o.Addr)
+	}
+
+	// 单独配置的指标监听地址不能与其他服务冲突，为空时与 REST API 共用监听地址
+	if addr := o.MetricsOptions.Addr; addr != "" && (addr == o.Addr || addr == o.GRPCOptions.Addr) {
+		return fmt.Errorf("metrics.addr '%s' must be different from addr and grpc.addr", addr)
+	}
+
 	return nil
 }
 
 func (o *ServerOptions) Config() (*apiserver.Config, error) {
 	return &apiserver.Config{
-		MySQLOptions: o.MySQLOptions,
-		Addr:         o.Addr,
+		Mode:              o.Mode,
+		DBOptions:         o.DBOptions,
+		MySQLOptions:      o.MySQLOptions,
+		SQLiteOptions:     o.SQLiteOptions,
+		JWTOptions:        o.JWTOptions,
+		UserOptions:       o.UserOptions,
+		PostOptions:       o.PostOptions,
+		TrashOptions:      o.TrashOptions,
+		PaginationOptions: o.PaginationOptions,
+		SearchOptions:     o.SearchOptions,
+		GRPCOptions:       o.GRPCOptions,
+		MetricsOptions:    o.MetricsOptions,
+		TracingOptions:    o.TracingOptions,
+		Addr:              o.Addr,
 	}, nil
 }
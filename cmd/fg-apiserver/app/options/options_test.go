@@ -0,0 +1,40 @@
+package options
+
+import (
+	"strings"
+	"testing"
+
+	genericoptions "github.com/onexstack/fastgo/pkg/options"
+)
+
+func TestValidateSearchDriver(t *testing.T) {
+	tests := []struct {
+		dbDriver     string
+		searchDriver string
+		wantErr      bool
+	}{
+		{dbDriver: genericoptions.DriverMySQL, searchDriver: genericoptions.SearchDriverMySQL},
+		{dbDriver: genericoptions.DriverMySQL, searchDriver: genericoptions.SearchDriverBleve},
+		{dbDriver: genericoptions.DriverSQLite, searchDriver: genericoptions.SearchDriverBleve},
+		{dbDriver: genericoptions.DriverMemory, searchDriver: genericoptions.SearchDriverBleve},
+		{dbDriver: genericoptions.DriverSQLite, searchDriver: genericoptions.SearchDriverMySQL, wantErr: true},
+		{dbDriver: genericoptions.DriverMemory, searchDriver: genericoptions.SearchDriverMySQL, wantErr: true},
+	}
+
+	for _, tt := range tests {
+		opts := NewServerOptions()
+		opts.DBOptions.Driver = tt.dbDriver
+		opts.SearchOptions.Driver = tt.searchDriver
+
+		err := opts.Validate()
+		if tt.wantErr {
+			if err == nil || !strings.Contains(err.Error(), "requires db.driver") {
+				t.Errorf("Validate() with db.driver %s and search.driver %s error = %v, want a driver mismatch error", tt.dbDriver, tt.searchDriver, err)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("Validate() with db.driver %s and search.driver %s error = %v", tt.dbDriver, tt.searchDriver, err)
+		}
+	}
+}
@@ -1,14 +1,17 @@
 package app
 
 import (
-	"io"
 	"log/slog"
 	"os"
+	"os/signal"
+	"syscall"
 
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
 	"github.com/onexstack/fastgo/cmd/fg-apiserver/app/options"
+	"github.com/onexstack/fastgo/internal/pkg/log"
+	genericoptions "github.com/onexstack/fastgo/pkg/options"
 	"github.com/onexstack/fastgo/pkg/version"
 )
 
@@ -38,6 +41,9 @@ func NewFastGOCommand() *cobra.Command {
 	// 添加 --version 标志
 	version.AddFlags(cmd.PersistentFlags())
 
+	// 添加数据库迁移子命令
+	cmd.AddCommand(newMigrateCommand(opts))
+
 	return cmd
 }
 
@@ -45,8 +51,6 @@ func run(opts *options.ServerOptions) error {
 	// 如果传入 --version，则打印版本信息并退出
 	version.PrintAndExitIfRequested()
 
-	initLog()
-
 	// 将 viper 中的配置解析到选项 opts 变量中.
 	if err := viper.Unmarshal(opts); err != nil {
 		return err
@@ -57,6 +61,11 @@ func run(opts *options.ServerOptions) error {
 		return err
 	}
 
+	if err := initLog(opts.LogOptions); err != nil {
+		return err
+	}
+	defer log.Close()
+
 	// 获取应用配置.
 	// 将命令行选项和应用配置分开，可以更加灵活的处理 2 种不同类型的配置.
 	cfg, err := opts.Config()
@@ -74,58 +83,46 @@ func run(opts *options.ServerOptions) error {
 	return server.Run()
 }
 
-func initLog() {
-	format := viper.GetString("log.format")
-	level := viper.GetString("log.level")
-	output := viper.GetString("log.output")
-
-	var slevel slog.Level
-	switch level {
-	case "debug":
-		slevel = slog.LevelDebug
-	case "info":
-		slevel = slog.LevelInfo
-	case "warn":
-		slevel = slog.LevelWarn
-	case "error":
-		slevel = slog.LevelError
-	default:
-		slevel = slog.LevelInfo
-	}
-
-	opts := slog.HandlerOptions{
-		Level: slevel,
+// initLog 根据配置初始化日志，并在收到 SIGHUP 信号时重新读取配置文件中的日志级别.
+func initLog(opts *genericoptions.LogOptions) error {
+	if err := log.Init(opts); err != nil {
+		return err
 	}
 
-	var w io.Writer
-	var err error
-
-	switch output {
-	case "":
-		w = os.Stdout
-	case "stdout":
-		w = os.Stderr
-	default:
-		w, err = os.OpenFile(output, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0666)
-		if err != nil {
-			panic(err)
+	hup := make(chan os.Signal, 1)
+	signal.Notify(hup, syscall.SIGHUP)
+	go func() {
+		for range hup {
+			if err := reloadLogLevel(); err != nil {
+				slog.Error("Failed to reload log level", "err", err)
+				continue
+			}
+
+			level, levels := log.GetLevel()
+			slog.Info("Reloaded log level", "level", level, "levels", levels)
 		}
-	}
+	}()
 
-	// 转换日志格式
-	if err != nil {
-		return
+	return nil
+}
+
+// reloadLogLevel 重新读取配置文件，更新日志级别和按包覆盖的日志级别. 日志输出不会重新创建.
+func reloadLogLevel() error {
+	if err := viper.ReadInConfig(); err != nil {
+		return err
 	}
 
-	var handler slog.Handler
-	switch format {
-	case "json":
-		handler = slog.NewJSONHandler(w, &opts)
-	case "text":
-		handler = slog.NewTextHandler(w, &opts)
-	default:
-		handler = slog.NewJSONHandler(w, &opts)
+	opts := genericoptions.NewLogOptions()
+	if err := viper.UnmarshalKey("log", opts); err != nil {
+		return err
+	}
+	if err := opts.Validate(); err != nil {
+		return err
 	}
 
-	slog.SetDefault(slog.New(handler))
+	// 配置文件中没有 levels 时清空按包覆盖的日志级别
+	if opts.Levels == nil {
+		opts.Levels = map[string]string{}
+	}
+	return log.SetLevel(opts.Level, opts.Levels)
 }
@@ -0,0 +1,85 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/spf13/cobra"
+	"gopkg.in/yaml.v3"
+
+	"github.com/onexstack/fastgo/internal/pkg/known"
+)
+
+const (
+	// defaultConfigName 指定 fgctl 的默认配置文件名.
+	defaultConfigName = "fgctl.yaml"
+
+	// defaultServer 是 fg-apiserver 的默认地址.
+	defaultServer = "http://127.0.0.1:6666"
+)
+
+// Config 是 fgctl 的配置文件，保存服务地址和登录后获得的令牌.
+// 配置文件中不保存密码，刷新令牌过期后需要重新登录.
+type Config struct {
+	// Server 是 fg-apiserver 的地址.
+	Server string `yaml:"server"`
+	// Username 是当前登录的用户名.
+	Username string `yaml:"username,omitempty"`
+	// UserID 是当前登录的用户 ID.
+	UserID string `yaml:"userID,omitempty"`
+	// Token 是访问令牌.
+	Token string `yaml:"token,omitempty"`
+	// ExpireAt 是访问令牌的过期时间.
+	ExpireAt time.Time `yaml:"expireAt,omitempty"`
+	// RefreshToken 是刷新令牌.
+	RefreshToken string `yaml:"refreshToken,omitempty"`
+	// RefreshExpireAt 是刷新令牌的过期时间.
+	RefreshExpireAt time.Time `yaml:"refreshExpireAt,omitempty"`
+}
+
+// filePath 返回默认的配置文件路径.
+func filePath() string {
+	home, err := os.UserHomeDir()
+	cobra.CheckErr(err)
+
+	return filepath.Join(home, known.HomeDir, defaultConfigName)
+}
+
+// loadConfig 读取配置文件，配置文件不存在时返回默认配置.
+func loadConfig(path string) (*Config, error) {
+	cfg := &Config{Server: defaultServer}
+
+	data, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return cfg, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if err := yaml.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config file %s: %w", path, err)
+	}
+	return cfg, nil
+}
+
+// save 将配置写入配置文件. 配置文件中保存了令牌，只允许当前用户读写.
+func (cfg *Config) save(path string) error {
+	data, err := yaml.Marshal(cfg)
+	if err != nil {
+		return err
+	}
+
+	if err := os.MkdirAll(filepath.Dir(path), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(path, data, 0o600)
+}
+
+// clearSession 清空登录信息，保留服务地址.
+func (cfg *Config) clearSession() {
+	*cfg = Config{Server: cfg.Server}
+}
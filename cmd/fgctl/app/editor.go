@@ -0,0 +1,71 @@
+package app
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"os/exec"
+	"strings"
+)
+
+// defaultEditor 是没有设置 $VISUAL 和 $EDITOR 时使用的编辑器.
+const defaultEditor = "vi"
+
+// errEmptyPost 表示编辑后的博客标题为空，视为放弃编辑.
+var errEmptyPost = errors.New("aborting due to empty title")
+
+// editPost 使用编辑器编辑博客的标题和内容. 文件的第一行是标题，空一行后是正文.
+func editPost(title string, content string) (string, string, error) {
+	f, err := os.CreateTemp("", "fgctl-post-*.md")
+	if err != nil {
+		return "", "", err
+	}
+	defer os.Remove(f.Name())
+
+	if _, err := f.WriteString(title + "\n\n" + content); err != nil {
+		f.Close()
+		return "", "", err
+	}
+	if err := f.Close(); err != nil {
+		return "", "", err
+	}
+
+	if err := runEditor(f.Name()); err != nil {
+		return "", "", err
+	}
+
+	data, err := os.ReadFile(f.Name())
+	if err != nil {
+		return "", "", err
+	}
+
+	title, content, _ = strings.Cut(string(data), "\n")
+	title = strings.TrimSpace(title)
+	if title == "" {
+		return "", "", errEmptyPost
+	}
+	return title, strings.TrimSpace(content), nil
+}
+
+// runEditor 打开 $VISUAL 或 $EDITOR 指定的编辑器编辑文件，编辑器可以带参数，例如 "code --wait".
+func runEditor(path string) error {
+	editor := os.Getenv("VISUAL")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	if editor == "" {
+		editor = defaultEditor
+	}
+
+	args := strings.Fields(editor)
+	if len(args) == 0 {
+		return fmt.Errorf("invalid editor '%s'", editor)
+	}
+
+	cmd := exec.Command(args[0], append(args[1:], path)...)
+	cmd.Stdin, cmd.Stdout, cmd.Stderr = os.Stdin, os.Stdout, os.Stderr
+	if err := cmd.Run(); err != nil {
+		return fmt.Errorf("editor '%s' failed: %w", editor, err)
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/spf13/cobra"
+
+	"github.com/onexstack/fastgo/pkg/client"
+	"github.com/onexstack/fastgo/pkg/version"
+)
+
+// ctlOptions 保存所有子命令共用的选项和配置.
+type ctlOptions struct {
+	// configFile 是配置文件路径.
+	configFile string
+	// server 是命令行指定的服务地址，为空时使用配置文件中的地址.
+	server string
+	// output 是输出格式，可选值为 table、json、yaml.
+	output string
+	// cfg 是从配置文件中读取的配置.
+	cfg *Config
+}
+
+// NewFGCtlCommand 创建 fgctl 命令.
+func NewFGCtlCommand() *cobra.Command {
+	o := &ctlOptions{}
+
+	cmd := &cobra.Command{
+		Use:           "fgctl",
+		Short:         "fgctl controls the fastgo apiserver",
+		Long:          `fgctl is the command line client of fg-apiserver, used to manage users and posts.`,
+		SilenceUsage:  true,
+		SilenceErrors: true,
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			// 如果传入 --version，则打印版本信息并退出
+			version.PrintAndExitIfRequested()
+
+			if !slices.Contains(outputFormats, o.output) {
+				return fmt.Errorf("invalid output format '%s', must be one of %v", o.output, outputFormats)
+			}
+
+			cfg, err := loadConfig(o.configFile)
+			if err != nil {
+				return err
+			}
+			if o.server != "" {
+				cfg.Server = o.server
+			}
+			o.cfg = cfg
+			return nil
+		},
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return cmd.Help()
+		},
+	}
+
+	cmd.PersistentFlags().StringVarP(&o.configFile, "config", "c", filePath(), "Path to the fgctl configuration file.")
+	cmd.PersistentFlags().StringVarP(&o.server, "server", "s", "", "Address of fg-apiserver, overrides the server in the configuration file.")
+	cmd.PersistentFlags().StringVarP(&o.output, "output", "o", outputTable, "Output format, one of table, json, yaml.")
+
+	// 添加 --version 标志
+	version.AddFlags(cmd.PersistentFlags())
+
+	cmd.AddCommand(
+		newLoginCommand(o),
+		newLogoutCommand(o),
+		newWhoamiCommand(o),
+		newUsersCommand(o),
+		newPostsCommand(o),
+	)
+
+	return cmd
+}
+
+// client 创建 fg-apiserver 的客户端，刷新后的令牌会自动保存到配置文件中.
+func (o *ctlOptions) client() (*client.Client, error) {
+	return client.New(o.cfg.Server,
+		client.WithToken(o.cfg.Token, o.cfg.RefreshToken),
+		client.WithTokenListener(func(token client.Token) {
+			o.cfg.Token, o.cfg.ExpireAt = token.Token, token.ExpireAt
+			o.cfg.RefreshToken, o.cfg.RefreshExpireAt = token.RefreshToken, token.RefreshExpireAt
+			_ = o.cfg.save(o.configFile)
+		}),
+	)
+}
+
+// loggedInClient 创建客户端，未登录时返回错误.
+func (o *ctlOptions) loggedInClient() (*client.Client, error) {
+	if o.cfg.Token == "" && o.cfg.RefreshToken == "" {
+		return nil, fmt.Errorf("not logged in, run 'fgctl login' first")
+	}
+	return o.client()
+}
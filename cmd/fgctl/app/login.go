@@ -0,0 +1,132 @@
+package app
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/spf13/cobra"
+	"golang.org/x/term"
+
+	v1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+	"github.com/onexstack/fastgo/pkg/client"
+)
+
+func newLoginCommand(o *ctlOptions) *cobra.Command {
+	var username, password string
+	var passwordStdin bool
+
+	cmd := &cobra.Command{
+		Use:   "login",
+		Short: "Log in to fg-apiserver and save the token",
+		Long: `Log in to fg-apiserver and save the access token and refresh token in the configuration file.
+The password is read from the terminal when --password and --password-stdin are not set.`,
+		Example: `  fgctl login -u root
+  fgctl login -s http://127.0.0.1:6666 -u root --password-stdin < password.txt`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			var err error
+			if username == "" {
+				if username, err = prompt(cmd, "Username: "); err != nil {
+					return err
+				}
+			}
+
+			switch {
+			case passwordStdin:
+				data, err := io.ReadAll(cmd.InOrStdin())
+				if err != nil {
+					return err
+				}
+				password = strings.TrimRight(string(data), "\r\n")
+			case password == "":
+				if password, err = readPassword(cmd); err != nil {
+					return err
+				}
+			}
+
+			o.cfg.clearSession()
+			c, err := o.client()
+			if err != nil {
+				return err
+			}
+			login, err := c.Users().Login(cmd.Context(), &v1.LoginRequest{Username: username, Password: password})
+			if err != nil {
+				return err
+			}
+
+			resp, err := c.Users().Get(cmd.Context(), &v1.GetUserRequest{UserID: login.UserID})
+			if err != nil {
+				return err
+			}
+			user := resp.User
+			o.cfg.Username, o.cfg.UserID = user.Username, user.UserID
+			if err := o.cfg.save(o.configFile); err != nil {
+				return err
+			}
+
+			fmt.Fprintf(cmd.OutOrStdout(), "Logged in to %s as %s (%s)\n", o.cfg.Server, user.Username, user.UserID)
+			return nil
+		},
+	}
+
+	cmd.Flags().StringVarP(&username, "username", "u", "", "Username to log in with.")
+	cmd.Flags().StringVarP(&password, "password", "p", "", "Password to log in with, prefer --password-stdin to keep it out of the shell history.")
+	cmd.Flags().BoolVar(&passwordStdin, "password-stdin", false, "Read the password from stdin.")
+
+	return cmd
+}
+
+func newLogoutCommand(o *ctlOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "logout",
+		Short: "Log out and remove the saved token",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := o.loggedInClient()
+			if err != nil {
+				return err
+			}
+
+			// 服务端的会话已经失效时，同样清除本地保存的令牌
+			_, err = c.Users().Logout(cmd.Context(), &v1.LogoutRequest{})
+			if err != nil && !client.IsReason(err, "Unauthenticated.TokenInvalid") && !client.IsReason(err, "Unauthenticated.TokenRevoked") {
+				return err
+			}
+
+			o.cfg.clearSession()
+			if err := o.cfg.save(o.configFile); err != nil {
+				return err
+			}
+
+			fmt.Fprintln(cmd.OutOrStdout(), "Logged out")
+			return nil
+		},
+	}
+}
+
+// prompt 输出提示并从标准输入读取一行.
+func prompt(cmd *cobra.Command, message string) (string, error) {
+	fmt.Fprint(cmd.ErrOrStderr(), message)
+	line, err := bufio.NewReader(cmd.InOrStdin()).ReadString('\n')
+	if err != nil && !errors.Is(err, io.EOF) {
+		return "", err
+	}
+	return strings.TrimSpace(line), nil
+}
+
+// readPassword 从终端读取密码，输入的内容不会回显. 标准输入不是终端时读取一行.
+func readPassword(cmd *cobra.Command) (string, error) {
+	fd := int(os.Stdin.Fd())
+	if !term.IsTerminal(fd) {
+		return prompt(cmd, "Password: ")
+	}
+
+	fmt.Fprint(cmd.ErrOrStderr(), "Password: ")
+	password, err := term.ReadPassword(fd)
+	fmt.Fprintln(cmd.ErrOrStderr())
+	return string(password), err
+}
@@ -0,0 +1,81 @@
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	"github.com/gosuri/uitable"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+// outputFormats 是支持的输出格式.
+var outputFormats = []string{outputTable, outputJSON, outputYAML}
+
+// printObject 按照输出格式打印接口的响应. JSON 和 YAML 格式输出完整的响应，
+// 表格格式调用 table 输出主要字段.
+func (o *ctlOptions) printObject(w io.Writer, obj any, table func(t *uitable.Table)) error {
+	switch o.output {
+	case outputJSON:
+		data, err := json.MarshalIndent(obj, "", "  ")
+		if err != nil {
+			return err
+		}
+		_, err = fmt.Fprintln(w, string(data))
+		return err
+	case outputYAML:
+		// 先转换为 JSON，使 YAML 中的字段名与 JSON 标签一致
+		data, err := json.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		var v any
+		if err := json.Unmarshal(data, &v); err != nil {
+			return err
+		}
+		enc := yaml.NewEncoder(w)
+		enc.SetIndent(2)
+		if err := enc.Encode(v); err != nil {
+			return err
+		}
+		return enc.Close()
+	default:
+		t := uitable.New()
+		t.MaxColWidth = 60
+		table(t)
+		_, err := fmt.Fprintln(w, t)
+		return err
+	}
+}
+
+// formatTime 格式化表格中的时间，为空时输出 -.
+func formatTime(t *time.Time) string {
+	if t == nil || t.IsZero() {
+		return "-"
+	}
+	return t.Local().Format(time.DateTime)
+}
+
+// formatList 格式化表格中的列表，为空时输出 -.
+func formatList(items []string) string {
+	if len(items) == 0 {
+		return "-"
+	}
+	return strings.Join(items, ",")
+}
+
+// orDash 在字符串为空时返回 -.
+func orDash(s string) string {
+	if s == "" {
+		return "-"
+	}
+	return s
+}
@@ -0,0 +1,304 @@
+package app
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gosuri/uitable"
+	"github.com/spf13/cobra"
+
+	v1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+)
+
+func newPostsCommand(o *ctlOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "posts",
+		Aliases: []string{"post"},
+		Short:   "Manage posts",
+		Args:    cobra.NoArgs,
+	}
+
+	cmd.AddCommand(
+		newPostsCreateCommand(o),
+		newPostsEditCommand(o),
+		newPostsListCommand(o),
+		newPostsGetCommand(o),
+		newPostsDeleteCommand(o),
+	)
+
+	return cmd
+}
+
+// addPostDetail 以键值对的形式输出博客详情.
+func addPostDetail(t *uitable.Table, post *v1.Post) {
+	t.AddRow("POST ID:", post.PostID)
+	t.AddRow("TITLE:", post.Title)
+	t.AddRow("USER ID:", post.UserID)
+	t.AddRow("STATUS:", post.Status)
+	t.AddRow("VISIBILITY:", post.Visibility)
+	t.AddRow("CATEGORY:", orDash(post.CategoryID))
+	t.AddRow("TAGS:", formatList(post.Tags))
+	t.AddRow("VERSION:", post.Version)
+	t.AddRow("PUBLISH AT:", formatTime(post.PublishAt))
+	t.AddRow("PUBLISHED AT:", formatTime(post.PublishedAt))
+	t.AddRow("CREATED AT:", formatTime(&post.CreatedAt))
+	t.AddRow("UPDATED AT:", formatTime(&post.UpdatedAt))
+}
+
+// readContent 读取 --content-file 指定的文件，- 表示标准输入.
+func readContent(cmd *cobra.Command, path string) (string, error) {
+	var data []byte
+	var err error
+	if path == "-" {
+		data, err = io.ReadAll(cmd.InOrStdin())
+	} else {
+		data, err = os.ReadFile(path)
+	}
+	return string(data), err
+}
+
+func newPostsCreateCommand(o *ctlOptions) *cobra.Command {
+	var rq v1.CreatePostRequest
+	var visibility, publishAt, contentFile string
+	var edit bool
+
+	cmd := &cobra.Command{
+		Use:   "create",
+		Short: "Create a post",
+		Long: `Create a post. When --title or the content is missing, or --edit is set, $EDITOR is opened to write the post:
+the first line is the title and the content follows after a blank line.`,
+		Example: `  fgctl posts create
+  fgctl posts create --title "Hello" --content-file hello.md --tags go,web --visibility public
+  fgctl posts create --title "Scheduled" --content "..." --publish-at 2030-01-01T08:00:00+08:00`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if contentFile != "" {
+				content, err := readContent(cmd, contentFile)
+				if err != nil {
+					return err
+				}
+				rq.Content = content
+			}
+
+			if edit || rq.Title == "" || rq.Content == "" {
+				title, content, err := editPost(rq.Title, rq.Content)
+				if err != nil {
+					return err
+				}
+				rq.Title, rq.Content = title, content
+			}
+
+			if cmd.Flags().Changed("visibility") {
+				rq.Visibility = &visibility
+			}
+			if publishAt != "" {
+				t, err := time.Parse(time.RFC3339, publishAt)
+				if err != nil {
+					return fmt.Errorf("invalid --publish-at '%s', must be in RFC 3339 format", publishAt)
+				}
+				rq.PublishAt = &t
+			}
+
+			c, err := o.loggedInClient()
+			if err != nil {
+				return err
+			}
+
+			resp, err := c.Posts().Create(cmd.Context(), &rq)
+			if err != nil {
+				return err
+			}
+
+			return o.printObject(cmd.OutOrStdout(), resp, func(t *uitable.Table) {
+				t.AddRow("POST ID:", resp.PostID)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&rq.Title, "title", "", "Title of the post.")
+	cmd.Flags().StringVar(&rq.Content, "content", "", "Content of the post.")
+	cmd.Flags().StringVarP(&contentFile, "content-file", "f", "", "Read the content from a file, - reads from stdin.")
+	cmd.Flags().StringSliceVar(&rq.Tags, "tags", nil, "Comma separated tags of the post.")
+	cmd.Flags().StringVar(&rq.CategoryID, "category", "", "Category ID of the post.")
+	cmd.Flags().StringVar(&visibility, "visibility", "", "Visibility of the post, one of private, unlisted, public.")
+	cmd.Flags().StringVar(&publishAt, "publish-at", "", "Schedule the post to be published at this time (RFC 3339).")
+	cmd.Flags().BoolVarP(&edit, "edit", "e", false, "Open $EDITOR even if the title and content are set.")
+
+	return cmd
+}
+
+func newPostsEditCommand(o *ctlOptions) *cobra.Command {
+	var title, content, category, visibility string
+	var tags []string
+
+	cmd := &cobra.Command{
+		Use:   "edit POST_ID",
+		Short: "Edit a post",
+		Long: `Edit a post. Without --title and --content, $EDITOR is opened with the current title and content.
+The update is rejected if the post has been modified by someone else in the meantime.`,
+		Example: `  fgctl posts edit post-abc123
+  fgctl posts edit post-abc123 --tags go,grpc --visibility public`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := o.loggedInClient()
+			if err != nil {
+				return err
+			}
+
+			current, err := c.Posts().Get(cmd.Context(), &v1.GetPostRequest{PostID: args[0]})
+			if err != nil {
+				return err
+			}
+
+			// 使用读取到的版本号，编辑期间博客被其他人修改时拒绝更新
+			rq := v1.UpdatePostRequest{PostID: args[0], Version: &current.Post.Version}
+			flags := cmd.Flags()
+			if flags.Changed("title") {
+				rq.Title = &title
+			}
+			if flags.Changed("content") {
+				rq.Content = &content
+			}
+			if flags.Changed("category") {
+				rq.CategoryID = &category
+			}
+			if flags.Changed("visibility") {
+				rq.Visibility = &visibility
+			}
+			if flags.Changed("tags") {
+				rq.Tags = &tags
+			}
+
+			if rq.Title == nil && rq.Content == nil && rq.CategoryID == nil && rq.Visibility == nil && rq.Tags == nil {
+				newTitle, newContent, err := editPost(current.Post.Title, current.Post.Content)
+				if err != nil {
+					return err
+				}
+				if newTitle == current.Post.Title && newContent == strings.TrimSpace(current.Post.Content) {
+					fmt.Fprintln(cmd.ErrOrStderr(), "No changes")
+					return nil
+				}
+				rq.Title, rq.Content = &newTitle, &newContent
+			}
+
+			resp, err := c.Posts().Update(cmd.Context(), &rq)
+			if err != nil {
+				return err
+			}
+
+			return o.printObject(cmd.OutOrStdout(), resp, func(t *uitable.Table) {
+				t.AddRow("POST ID:", rq.PostID)
+				t.AddRow("VERSION:", resp.Version)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&title, "title", "", "New title of the post.")
+	cmd.Flags().StringVar(&content, "content", "", "New content of the post.")
+	cmd.Flags().StringSliceVar(&tags, "tags", nil, "Comma separated tags, replaces all existing tags.")
+	cmd.Flags().StringVar(&category, "category", "", "New category ID, empty removes the category.")
+	cmd.Flags().StringVar(&visibility, "visibility", "", "New visibility, one of private, unlisted, public.")
+
+	return cmd
+}
+
+func newPostsListCommand(o *ctlOptions) *cobra.Command {
+	var rq v1.ListPostRequest
+	var status, category string
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List posts",
+		Example: `  fgctl posts list --status published --tags go
+  fgctl posts list --filter "title~grpc" --order-by "updatedAt desc" -o json`,
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if cmd.Flags().Changed("status") {
+				rq.Status = &status
+			}
+			if cmd.Flags().Changed("category") {
+				rq.CategoryID = &category
+			}
+
+			c, err := o.loggedInClient()
+			if err != nil {
+				return err
+			}
+
+			resp, err := c.Posts().List(cmd.Context(), &rq)
+			if err != nil {
+				return err
+			}
+
+			err = o.printObject(cmd.OutOrStdout(), resp, func(t *uitable.Table) {
+				t.AddRow("POST ID", "TITLE", "STATUS", "VISIBILITY", "TAGS", "UPDATED AT")
+				for _, post := range resp.Posts {
+					t.AddRow(post.PostID, post.Title, post.Status, post.Visibility, formatList(post.Tags), formatTime(&post.UpdatedAt))
+				}
+			})
+			printPageFooter(cmd, o, resp.TotalCount, resp.NextPageToken)
+			return err
+		},
+	}
+
+	addPageFlags(cmd, &rq.PageRequest, &rq.QueryRequest)
+	cmd.Flags().StringVar(&status, "status", "", "Only list posts in this status, one of draft, scheduled, published, archived.")
+	cmd.Flags().StringSliceVar(&rq.Tags, "tags", nil, "Only list posts with these tags.")
+	cmd.Flags().StringVar(&rq.TagMode, "tag-mode", "", "How to match --tags, any (default) or all.")
+	cmd.Flags().StringVar(&category, "category", "", "Only list posts in this category or its subcategories.")
+
+	return cmd
+}
+
+func newPostsGetCommand(o *ctlOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get POST_ID",
+		Short: "Show the details of a post",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := o.loggedInClient()
+			if err != nil {
+				return err
+			}
+
+			resp, err := c.Posts().Get(cmd.Context(), &v1.GetPostRequest{PostID: args[0]})
+			if err != nil {
+				return err
+			}
+
+			err = o.printObject(cmd.OutOrStdout(), resp.Post, func(t *uitable.Table) {
+				addPostDetail(t, resp.Post)
+			})
+			if err == nil && o.output == outputTable {
+				fmt.Fprintf(cmd.OutOrStdout(), "\n%s\n", resp.Post.Content)
+			}
+			return err
+		},
+	}
+}
+
+func newPostsDeleteCommand(o *ctlOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete POST_ID...",
+		Short: "Move posts to the trash",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := o.loggedInClient()
+			if err != nil {
+				return err
+			}
+
+			if _, err := c.Posts().Delete(cmd.Context(), &v1.DeletePostRequest{PostIDs: args}); err != nil {
+				return err
+			}
+
+			for _, postID := range args {
+				fmt.Fprintf(cmd.OutOrStdout(), "Moved %s to the trash\n", postID)
+			}
+			return nil
+		},
+	}
+}
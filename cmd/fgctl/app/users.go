@@ -0,0 +1,207 @@
+package app
+
+import (
+	"fmt"
+
+	"github.com/gosuri/uitable"
+	"github.com/spf13/cobra"
+
+	v1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+)
+
+func newUsersCommand(o *ctlOptions) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "users",
+		Aliases: []string{"user"},
+		Short:   "Manage users",
+		Args:    cobra.NoArgs,
+	}
+
+	cmd.AddCommand(
+		newUsersListCommand(o),
+		newUsersGetCommand(o),
+		newUsersUpdateCommand(o),
+		newUsersDeleteCommand(o),
+	)
+
+	return cmd
+}
+
+// addPageFlags 添加列表命令共用的分页和过滤选项.
+func addPageFlags(cmd *cobra.Command, page *v1.PageRequest, query *v1.QueryRequest) {
+	cmd.Flags().Int64Var(&page.Limit, "limit", 20, "Maximum number of items to return.")
+	cmd.Flags().StringVar(&page.PageToken, "page-token", "", "Page token returned by the previous page.")
+	cmd.Flags().BoolVar(&page.WithTotal, "with-total", false, "Also return the total number of items.")
+	cmd.Flags().StringVar(&query.Filter, "filter", "", `Filter expression, for example "createdAt>2024-01-01 AND title~go".`)
+	cmd.Flags().StringVar(&query.OrderBy, "order-by", "", `Sort order, for example "updatedAt desc".`)
+}
+
+// printPageFooter 在表格后输出总数和下一页的分页令牌.
+func printPageFooter(cmd *cobra.Command, o *ctlOptions, total *int64, nextPageToken string) {
+	if o.output != outputTable {
+		return
+	}
+	if total != nil {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Total: %d\n", *total)
+	}
+	if nextPageToken != "" {
+		fmt.Fprintf(cmd.ErrOrStderr(), "Next page: --page-token %s\n", nextPageToken)
+	}
+}
+
+// addUserDetail 以键值对的形式输出用户详情.
+func addUserDetail(t *uitable.Table, user *v1.User) {
+	t.AddRow("USER ID:", user.UserID)
+	t.AddRow("USERNAME:", user.Username)
+	t.AddRow("NICKNAME:", orDash(user.Nickname))
+	t.AddRow("EMAIL:", user.Email)
+	t.AddRow("PHONE:", user.Phone)
+	t.AddRow("ROLE:", user.Role)
+	t.AddRow("POSTS:", user.PostCount)
+	t.AddRow("VERSION:", user.Version)
+	t.AddRow("DELETION SCHEDULED AT:", formatTime(user.DeletionScheduledAt))
+	t.AddRow("CREATED AT:", formatTime(&user.CreatedAt))
+	t.AddRow("UPDATED AT:", formatTime(&user.UpdatedAt))
+}
+
+func newUsersListCommand(o *ctlOptions) *cobra.Command {
+	var rq v1.ListUserRequest
+
+	cmd := &cobra.Command{
+		Use:   "list",
+		Short: "List users",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := o.loggedInClient()
+			if err != nil {
+				return err
+			}
+
+			resp, err := c.Users().List(cmd.Context(), &rq)
+			if err != nil {
+				return err
+			}
+
+			err = o.printObject(cmd.OutOrStdout(), resp, func(t *uitable.Table) {
+				t.AddRow("USER ID", "USERNAME", "NICKNAME", "EMAIL", "ROLE", "POSTS", "CREATED AT")
+				for _, user := range resp.Users {
+					t.AddRow(user.UserID, user.Username, orDash(user.Nickname), user.Email, user.Role, user.PostCount, formatTime(&user.CreatedAt))
+				}
+			})
+			printPageFooter(cmd, o, resp.TotalCount, resp.NextPageToken)
+			return err
+		},
+	}
+
+	addPageFlags(cmd, &rq.PageRequest, &rq.QueryRequest)
+
+	return cmd
+}
+
+func newUsersGetCommand(o *ctlOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "get USER_ID",
+		Short: "Show the details of a user",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := o.loggedInClient()
+			if err != nil {
+				return err
+			}
+
+			resp, err := c.Users().Get(cmd.Context(), &v1.GetUserRequest{UserID: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return o.printObject(cmd.OutOrStdout(), resp.User, func(t *uitable.Table) {
+				addUserDetail(t, resp.User)
+			})
+		},
+	}
+}
+
+func newUsersUpdateCommand(o *ctlOptions) *cobra.Command {
+	var username, nickname, email, phone string
+	var version int64
+
+	cmd := &cobra.Command{
+		Use:   "update USER_ID",
+		Short: "Update a user",
+		Long:  `Update a user, only the fields given on the command line are changed.`,
+		Example: `  fgctl users update user-abc123 --nickname colin --email colin@example.com
+  fgctl users update user-abc123 --phone 13800000000 --if-version 3`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			rq := v1.UpdateUserRequest{UserID: args[0]}
+			flags := cmd.Flags()
+			if flags.Changed("username") {
+				rq.Username = &username
+			}
+			if flags.Changed("nickname") {
+				rq.Nickname = &nickname
+			}
+			if flags.Changed("email") {
+				rq.Email = &email
+			}
+			if flags.Changed("phone") {
+				rq.Phone = &phone
+			}
+			if flags.Changed("if-version") {
+				rq.Version = &version
+			}
+			if rq.Username == nil && rq.Nickname == nil && rq.Email == nil && rq.Phone == nil {
+				return fmt.Errorf("nothing to update, set at least one of --username, --nickname, --email, --phone")
+			}
+
+			c, err := o.loggedInClient()
+			if err != nil {
+				return err
+			}
+
+			resp, err := c.Users().Update(cmd.Context(), &rq)
+			if err != nil {
+				return err
+			}
+
+			return o.printObject(cmd.OutOrStdout(), resp, func(t *uitable.Table) {
+				t.AddRow("USER ID:", rq.UserID)
+				t.AddRow("VERSION:", resp.Version)
+			})
+		},
+	}
+
+	cmd.Flags().StringVar(&username, "username", "", "New username.")
+	cmd.Flags().StringVar(&nickname, "nickname", "", "New nickname.")
+	cmd.Flags().StringVar(&email, "email", "", "New email.")
+	cmd.Flags().StringVar(&phone, "phone", "", "New phone number.")
+	cmd.Flags().Int64Var(&version, "if-version", 0, "Only update when the user is still at this version.")
+
+	return cmd
+}
+
+func newUsersDeleteCommand(o *ctlOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete USER_ID",
+		Short: "Schedule the deletion of a user account",
+		Long: `Schedule the deletion of a user account. The account and all of its data are deleted after the grace period,
+until then the deletion can be cancelled.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := o.loggedInClient()
+			if err != nil {
+				return err
+			}
+
+			resp, err := c.Users().Delete(cmd.Context(), &v1.DeleteUserRequest{UserID: args[0]})
+			if err != nil {
+				return err
+			}
+
+			return o.printObject(cmd.OutOrStdout(), resp, func(t *uitable.Table) {
+				t.AddRow("USER ID:", args[0])
+				t.AddRow("DELETION SCHEDULED AT:", formatTime(&resp.DeletionScheduledAt))
+			})
+		},
+	}
+}
@@ -0,0 +1,32 @@
+package app
+
+import (
+	"github.com/gosuri/uitable"
+	"github.com/spf13/cobra"
+
+	v1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+)
+
+func newWhoamiCommand(o *ctlOptions) *cobra.Command {
+	return &cobra.Command{
+		Use:   "whoami",
+		Short: "Show the logged in user",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			c, err := o.loggedInClient()
+			if err != nil {
+				return err
+			}
+
+			resp, err := c.Users().Get(cmd.Context(), &v1.GetUserRequest{UserID: o.cfg.UserID})
+			if err != nil {
+				return err
+			}
+
+			return o.printObject(cmd.OutOrStdout(), resp.User, func(t *uitable.Table) {
+				t.AddRow("SERVER:", o.cfg.Server)
+				addUserDetail(t, resp.User)
+			})
+		},
+	}
+}
@@ -0,0 +1,24 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/onexstack/onexstack/pkg/errorsx"
+
+	"github.com/onexstack/fastgo/cmd/fgctl/app"
+)
+
+func main() {
+	command := app.NewFGCtlCommand()
+	if err := command.Execute(); err != nil {
+		// 服务端返回的错误只输出 reason 和 message，避免打印冗长的结构体
+		if errx := new(errorsx.ErrorX); errors.As(err, &errx) {
+			fmt.Fprintf(os.Stderr, "Error: %s (%s)\n", errx.Message, errx.Reason)
+		} else {
+			fmt.Fprintf(os.Stderr, "Error: %v\n", err)
+		}
+		os.Exit(1)
+	}
+}
@@ -1,32 +1,64 @@
 package biz
 
 import (
+	categoryv1 "github.com/onexstack/fastgo/internal/apiserver/biz/v1/category"
+	commentv1 "github.com/onexstack/fastgo/internal/apiserver/biz/v1/comment"
+	policyv1 "github.com/onexstack/fastgo/internal/apiserver/biz/v1/policy"
 	postv1 "github.com/onexstack/fastgo/internal/apiserver/biz/v1/post"
+	tagv1 "github.com/onexstack/fastgo/internal/apiserver/biz/v1/tag"
 	userv1 "github.com/onexstack/fastgo/internal/apiserver/biz/v1/user"
+	"github.com/onexstack/fastgo/internal/apiserver/search"
 	"github.com/onexstack/fastgo/internal/apiserver/store"
+	genericoptions "github.com/onexstack/fastgo/pkg/options"
 )
 
 type IBiz interface {
 	UserV1() userv1.UserBiz
 	PostV1() postv1.PostBiz
+	PolicyV1() policyv1.PolicyBiz
+	TagV1() tagv1.TagBiz
+	CategoryV1() categoryv1.CategoryBiz
+	CommentV1() commentv1.CommentBiz
 }
 
 type biz struct {
-	store store.IStore
+	store       store.IStore
+	search      search.Index
+	userOptions *genericoptions.UserOptions
+	postOptions *genericoptions.PostOptions
 }
 
 var _ IBiz = (*biz)(nil)
 
-func NewBiz(store store.IStore) *biz {
+func NewBiz(store store.IStore, search search.Index, userOptions *genericoptions.UserOptions, postOptions *genericoptions.PostOptions) *biz {
 	return &biz{
-		store: store,
+		store:       store,
+		search:      search,
+		userOptions: userOptions,
+		postOptions: postOptions,
 	}
 }
 
 func (b *biz) UserV1() userv1.UserBiz {
-	return userv1.New(b.store)
+	return userv1.New(b.store, b.search, b.userOptions)
 }
 
 func (b *biz) PostV1() postv1.PostBiz {
-	return postv1.New(b.store)
+	return postv1.New(b.store, b.search, b.postOptions)
+}
+
+func (b *biz) PolicyV1() policyv1.PolicyBiz {
+	return policyv1.New(b.store)
+}
+
+func (b *biz) TagV1() tagv1.TagBiz {
+	return tagv1.New(b.store)
+}
+
+func (b *biz) CategoryV1() categoryv1.CategoryBiz {
+	return categoryv1.New(b.store)
+}
+
+func (b *biz) CommentV1() commentv1.CommentBiz {
+	return commentv1.New(b.store)
 }
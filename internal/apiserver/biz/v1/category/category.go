@@ -0,0 +1,113 @@
+package category
+
+import (
+	"context"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+
+	"github.com/onexstack/fastgo/internal/apiserver/model"
+	"github.com/onexstack/fastgo/internal/apiserver/pkg/conversion"
+	"github.com/onexstack/fastgo/internal/apiserver/store"
+	"github.com/onexstack/fastgo/internal/pkg/errorsx"
+	apiv1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+)
+
+// CategoryBiz 定义处理分类请求所需的方法.
+type CategoryBiz interface {
+	Create(ctx context.Context, rq *apiv1.CreateCategoryRequest) (*apiv1.CreateCategoryResponse, error)
+	Delete(ctx context.Context, rq *apiv1.DeleteCategoryRequest) (*apiv1.DeleteCategoryResponse, error)
+	List(ctx context.Context, rq *apiv1.ListCategoryRequest) (*apiv1.ListCategoryResponse, error)
+
+	CategoryExpansion
+}
+
+// CategoryExpansion 定义额外的分类操作方法.
+type CategoryExpansion interface{}
+
+type categoryBiz struct {
+	store store.IStore
+}
+
+var _ CategoryBiz = (*categoryBiz)(nil)
+
+func New(store store.IStore) *categoryBiz {
+	return &categoryBiz{
+		store: store,
+	}
+}
+
+func (b *categoryBiz) Create(ctx context.Context, rq *apiv1.CreateCategoryRequest) (*apiv1.CreateCategoryResponse, error) {
+	// 分类路径在创建后由 AfterCreate 钩子补全，这里先设置为父分类的路径
+	categoryM := model.Category{Name: rq.Name, ParentID: rq.ParentID, Path: "/"}
+	if rq.ParentID != "" {
+		parent, err := b.store.Category().Get(ctx, where.F("categoryID", rq.ParentID))
+		if err != nil {
+			return nil, err
+		}
+		categoryM.Path = parent.Path
+	}
+
+	if err := b.store.Category().Create(ctx, &categoryM); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.CreateCategoryResponse{CategoryID: categoryM.CategoryID}, nil
+}
+
+// Delete 删除分类，分类下仍有子分类或博客（包括回收站中的博客）时拒绝删除.
+func (b *categoryBiz) Delete(ctx context.Context, rq *apiv1.DeleteCategoryRequest) (*apiv1.DeleteCategoryResponse, error) {
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		if _, err := b.store.Category().Get(ctx, where.F("categoryID", rq.CategoryID)); err != nil {
+			return err
+		}
+
+		children, _, err := b.store.Category().List(ctx, where.F("parentID", rq.CategoryID).L(1))
+		if err != nil {
+			return err
+		}
+		posts, _, err := b.store.Post().List(ctx, where.F("categoryID", rq.CategoryID).L(1))
+		if err != nil {
+			return err
+		}
+		// 回收站中的博客恢复后仍然属于该分类，同样需要计入
+		trashed, _, err := b.store.Post().ListTrashed(ctx, where.F("categoryID", rq.CategoryID).L(1))
+		if err != nil {
+			return err
+		}
+		if children > 0 || posts > 0 || trashed > 0 {
+			return errorsx.ErrCategoryNotEmpty
+		}
+
+		return b.store.Category().Delete(ctx, where.F("categoryID", rq.CategoryID))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.DeleteCategoryResponse{}, nil
+}
+
+func (b *categoryBiz) List(ctx context.Context, rq *apiv1.ListCategoryRequest) (*apiv1.ListCategoryResponse, error) {
+	whr := where.NewWhere()
+	scope := conversion.PageScope("categories", rq.Filter)
+	page, err := conversion.ListRequestToOptions(whr, &rq.PageRequest, &rq.QueryRequest, store.CategorySchema, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	ret, err := b.store.Category().Page(ctx, whr, page)
+	if err != nil {
+		return nil, err
+	}
+
+	categories := make([]*apiv1.Category, 0, len(ret.Items))
+	for _, item := range ret.Items {
+		categories = append(categories, conversion.CategoryModelToCategoryV1(item))
+	}
+
+	return &apiv1.ListCategoryResponse{
+		TotalCount:    ret.Total,
+		Categories:    categories,
+		NextPageToken: conversion.NextPageToken(ret.Next, scope),
+	}, nil
+}
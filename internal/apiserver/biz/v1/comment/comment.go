@@ -0,0 +1,183 @@
+package comment
+
+import (
+	"context"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+
+	"github.com/onexstack/fastgo/internal/apiserver/model"
+	"github.com/onexstack/fastgo/internal/apiserver/pkg/conversion"
+	"github.com/onexstack/fastgo/internal/apiserver/store"
+	"github.com/onexstack/fastgo/internal/pkg/contextx"
+	"github.com/onexstack/fastgo/internal/pkg/errorsx"
+	"github.com/onexstack/fastgo/internal/pkg/known"
+	apiv1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+)
+
+// CommentBiz 定义处理评论请求所需的方法.
+type CommentBiz interface {
+	Create(ctx context.Context, rq *apiv1.CreateCommentRequest) (*apiv1.CreateCommentResponse, error)
+	Update(ctx context.Context, rq *apiv1.UpdateCommentRequest) (*apiv1.UpdateCommentResponse, error)
+	Delete(ctx context.Context, rq *apiv1.DeleteCommentRequest) (*apiv1.DeleteCommentResponse, error)
+	List(ctx context.Context, rq *apiv1.ListCommentRequest) (*apiv1.ListCommentResponse, error)
+
+	CommentExpansion
+}
+
+// CommentExpansion 定义额外的评论操作方法.
+type CommentExpansion interface{}
+
+type commentBiz struct {
+	store store.IStore
+}
+
+var _ CommentBiz = (*commentBiz)(nil)
+
+func New(store store.IStore) *commentBiz {
+	return &commentBiz{
+		store: store,
+	}
+}
+
+func (b *commentBiz) Create(ctx context.Context, rq *apiv1.CreateCommentRequest) (*apiv1.CreateCommentResponse, error) {
+	if _, err := b.visiblePost(ctx, rq.PostID); err != nil {
+		return nil, err
+	}
+
+	// 回复的评论必须属于同一篇博客，并且没有被删除
+	if rq.ParentID != "" {
+		parent, err := b.store.Comment().Get(ctx, where.F("postID", rq.PostID, "commentID", rq.ParentID))
+		if err != nil {
+			return nil, err
+		}
+		if parent.DeletedAt != nil {
+			return nil, errorsx.ErrCommentDeleted
+		}
+	}
+
+	commentM := model.Comment{
+		PostID:   rq.PostID,
+		UserID:   contextx.UserID(ctx),
+		ParentID: rq.ParentID,
+		Content:  rq.Content,
+	}
+	if err := b.store.Comment().Create(ctx, &commentM); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.CreateCommentResponse{CommentID: commentM.CommentID}, nil
+}
+
+// Update 编辑评论. 只有评论者本人可以在评论创建后的一段时间内编辑评论.
+func (b *commentBiz) Update(ctx context.Context, rq *apiv1.UpdateCommentRequest) (*apiv1.UpdateCommentResponse, error) {
+	if _, err := b.visiblePost(ctx, rq.PostID); err != nil {
+		return nil, err
+	}
+
+	commentM, err := b.store.Comment().Get(ctx, where.F("postID", rq.PostID, "commentID", rq.CommentID))
+	if err != nil {
+		return nil, err
+	}
+
+	// 已删除的评论不再保留评论者，需要先判断是否已删除
+	if commentM.DeletedAt != nil {
+		return nil, errorsx.ErrCommentDeleted
+	}
+	if commentM.UserID != contextx.UserID(ctx) {
+		return nil, errorsx.ErrPermissionDenied
+	}
+	if time.Since(commentM.CreatedAt) > known.CommentEditWindow {
+		return nil, errorsx.ErrCommentEditWindowExpired
+	}
+
+	commentM.Content = rq.Content
+	if err := b.store.Comment().Update(ctx, commentM); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.UpdateCommentResponse{}, nil
+}
+
+// Delete 删除评论. 评论者本人、博客作者和管理员可以删除评论.
+// 删除采用软删除，评论在评论树中的位置保留，回复不受影响.
+func (b *commentBiz) Delete(ctx context.Context, rq *apiv1.DeleteCommentRequest) (*apiv1.DeleteCommentResponse, error) {
+	postM, err := b.visiblePost(ctx, rq.PostID)
+	if err != nil {
+		return nil, err
+	}
+
+	commentM, err := b.store.Comment().Get(ctx, where.F("postID", rq.PostID, "commentID", rq.CommentID))
+	if err != nil {
+		return nil, err
+	}
+
+	// 已删除的评论不再保留评论者，重复删除直接返回成功
+	if commentM.DeletedAt != nil {
+		return &apiv1.DeleteCommentResponse{}, nil
+	}
+
+	userID := contextx.UserID(ctx)
+	if commentM.UserID != userID && postM.UserID != userID && contextx.Role(ctx) != known.RoleAdmin {
+		return nil, errorsx.ErrPermissionDenied
+	}
+
+	// 清空评论者和评论内容，不在数据库中保留已删除的内容，也不能再通过 userID 过滤出已删除的评论
+	now := time.Now()
+	commentM.DeletedAt = &now
+	commentM.UserID = ""
+	commentM.Content = ""
+	if err := b.store.Comment().Update(ctx, commentM); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.DeleteCommentResponse{}, nil
+}
+
+func (b *commentBiz) List(ctx context.Context, rq *apiv1.ListCommentRequest) (*apiv1.ListCommentResponse, error) {
+	if _, err := b.visiblePost(ctx, rq.PostID); err != nil {
+		return nil, err
+	}
+
+	whr := where.F("postID", rq.PostID)
+	scope := conversion.PageScope("comments", rq.PostID, rq.Filter)
+	page, err := conversion.ListRequestToOptions(whr, &rq.PageRequest, &rq.QueryRequest, store.CommentSchema, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	ret, err := b.store.Comment().Page(ctx, whr, page)
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make([]*apiv1.Comment, 0, len(ret.Items))
+	for _, item := range ret.Items {
+		comments = append(comments, conversion.CommentModelToCommentV1(item))
+	}
+
+	return &apiv1.ListCommentResponse{
+		TotalCount:    ret.Total,
+		Comments:      comments,
+		NextPageToken: conversion.NextPageToken(ret.Next, scope),
+	}, nil
+}
+
+// visiblePost 返回当前用户可以访问的博客.
+// 作者和管理员可以访问所有博客，其他用户只能访问已发布的公开或不公开列出的博客.
+func (b *commentBiz) visiblePost(ctx context.Context, postID string) (*model.Post, error) {
+	postM, err := b.store.Post().Get(ctx, where.F("postID", postID))
+	if err != nil {
+		return nil, err
+	}
+
+	if postM.UserID == contextx.UserID(ctx) || contextx.Role(ctx) == known.RoleAdmin {
+		return postM, nil
+	}
+
+	if postM.Status != known.PostStatusPublished || postM.Visibility == known.PostVisibilityPrivate {
+		return nil, errorsx.ErrPostNotFound
+	}
+
+	return postM, nil
+}
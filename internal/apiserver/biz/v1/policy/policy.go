@@ -0,0 +1,104 @@
+package policy
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+
+	"github.com/onexstack/fastgo/internal/apiserver/model"
+	"github.com/onexstack/fastgo/internal/apiserver/pkg/conversion"
+	"github.com/onexstack/fastgo/internal/apiserver/store"
+	"github.com/onexstack/fastgo/internal/pkg/errorsx"
+	apiv1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+)
+
+// PolicyBiz 定义处理授权策略请求所需的方法.
+type PolicyBiz interface {
+	Create(ctx context.Context, rq *apiv1.CreatePolicyRequest) (*apiv1.CreatePolicyResponse, error)
+	Delete(ctx context.Context, rq *apiv1.DeletePolicyRequest) (*apiv1.DeletePolicyResponse, error)
+	List(ctx context.Context, rq *apiv1.ListPolicyRequest) (*apiv1.ListPolicyResponse, error)
+
+	PolicyExpansion
+}
+
+// PolicyExpansion 定义额外的授权策略操作方法.
+type PolicyExpansion interface{}
+
+type policyBiz struct {
+	store store.IStore
+}
+
+var _ PolicyBiz = (*policyBiz)(nil)
+
+func New(store store.IStore) *policyBiz {
+	return &policyBiz{
+		store: store,
+	}
+}
+
+func (b *policyBiz) Create(ctx context.Context, rq *apiv1.CreatePolicyRequest) (*apiv1.CreatePolicyResponse, error) {
+	policyM := model.Policy{
+		Role:   rq.Role,
+		Path:   rq.Path,
+		Method: strings.ToUpper(rq.Method),
+	}
+
+	_, err := b.store.Policy().Get(ctx, where.F("role", policyM.Role, "path", policyM.Path, "method", policyM.Method))
+	if err == nil {
+		return nil, errorsx.ErrPolicyAlreadyExists
+	}
+	if !errors.Is(err, errorsx.ErrPolicyNotFound) {
+		return nil, err
+	}
+
+	if err := b.store.Policy().Create(ctx, &policyM); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.CreatePolicyResponse{}, nil
+}
+
+func (b *policyBiz) Delete(ctx context.Context, rq *apiv1.DeletePolicyRequest) (*apiv1.DeletePolicyResponse, error) {
+	whr := where.F("role", rq.Role, "path", rq.Path, "method", strings.ToUpper(rq.Method))
+
+	if _, err := b.store.Policy().Get(ctx, whr); err != nil {
+		return nil, err
+	}
+
+	if err := b.store.Policy().Delete(ctx, whr); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.DeletePolicyResponse{}, nil
+}
+
+func (b *policyBiz) List(ctx context.Context, rq *apiv1.ListPolicyRequest) (*apiv1.ListPolicyResponse, error) {
+	whr := where.NewWhere()
+	if rq.Role != nil {
+		whr = whr.F("role", *rq.Role)
+	}
+
+	scope := conversion.PageScope("policies", rq.Role, rq.Filter)
+	page, err := conversion.ListRequestToOptions(whr, &rq.PageRequest, &rq.QueryRequest, store.PolicySchema, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	ret, err := b.store.Policy().Page(ctx, whr, page)
+	if err != nil {
+		return nil, err
+	}
+
+	policies := make([]*apiv1.Policy, 0, len(ret.Items))
+	for _, item := range ret.Items {
+		policies = append(policies, conversion.PolicyModelToPolicyV1(item))
+	}
+
+	return &apiv1.ListPolicyResponse{
+		TotalCount:    ret.Total,
+		Policies:      policies,
+		NextPageToken: conversion.NextPageToken(ret.Next, scope),
+	}, nil
+}
@@ -2,13 +2,21 @@ package post
 
 import (
 	"context"
+	"log/slog"
+	"time"
 
 	"github.com/jinzhu/copier"
+	tagv1 "github.com/onexstack/fastgo/internal/apiserver/biz/v1/tag"
 	"github.com/onexstack/fastgo/internal/apiserver/model"
 	"github.com/onexstack/fastgo/internal/apiserver/pkg/conversion"
+	"github.com/onexstack/fastgo/internal/apiserver/search"
 	"github.com/onexstack/fastgo/internal/apiserver/store"
 	"github.com/onexstack/fastgo/internal/pkg/contextx"
+	"github.com/onexstack/fastgo/internal/pkg/errorsx"
+	"github.com/onexstack/fastgo/internal/pkg/known"
+	"github.com/onexstack/fastgo/internal/pkg/metrics"
 	apiv1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+	genericoptions "github.com/onexstack/fastgo/pkg/options"
 	"github.com/onexstack/onexstack/pkg/store/where"
 )
 
@@ -24,27 +32,85 @@ type PostBiz interface {
 }
 
 // PostExpansion 定义额外的帖子操作方法.
-type PostExpansion interface{}
+type PostExpansion interface {
+	Publish(ctx context.Context, rq *apiv1.PublishPostRequest) (*apiv1.PublishPostResponse, error)
+	Unpublish(ctx context.Context, rq *apiv1.UnpublishPostRequest) (*apiv1.UnpublishPostResponse, error)
+	// PublishScheduled 发布所有到期的定时发布博客，由后台任务定期调用.
+	PublishScheduled(ctx context.Context) (int64, error)
+	// ListPublic 返回已发布的公开博客，供未登录的读者浏览.
+	ListPublic(ctx context.Context, rq *apiv1.ListPublicPostRequest) (*apiv1.ListPublicPostResponse, error)
+	// GetPublic 返回已发布的公开或不公开列出的博客.
+	GetPublic(ctx context.Context, rq *apiv1.GetPublicPostRequest) (*apiv1.GetPublicPostResponse, error)
+	// ListRevisions 返回博客的修订版本列表，不包含版本内容.
+	ListRevisions(ctx context.Context, rq *apiv1.ListPostRevisionRequest) (*apiv1.ListPostRevisionResponse, error)
+	// GetRevision 返回博客的指定修订版本.
+	GetRevision(ctx context.Context, rq *apiv1.GetPostRevisionRequest) (*apiv1.GetPostRevisionResponse, error)
+	// DiffRevisions 逐行比较博客的两个修订版本.
+	DiffRevisions(ctx context.Context, rq *apiv1.DiffPostRevisionRequest) (*apiv1.DiffPostRevisionResponse, error)
+	// RestoreRevision 将博客恢复为指定修订版本的标题和内容，并生成一个新的修订版本.
+	RestoreRevision(ctx context.Context, rq *apiv1.RestorePostRevisionRequest) (*apiv1.RestorePostRevisionResponse, error)
+	// ListTrash 返回回收站中的博客.
+	ListTrash(ctx context.Context, rq *apiv1.ListTrashPostRequest) (*apiv1.ListTrashPostResponse, error)
+	// Restore 将博客从回收站中恢复.
+	Restore(ctx context.Context, rq *apiv1.RestorePostRequest) (*apiv1.RestorePostResponse, error)
+	// PurgeTrash 永久删除在 before 之前移入回收站的博客，由后台任务定期调用.
+	PurgeTrash(ctx context.Context, before time.Time) (int64, error)
+	// Search 在当前用户有权查看的博客中全文检索，按照相关度排列.
+	Search(ctx context.Context, rq *apiv1.SearchPostRequest) (*apiv1.SearchPostResponse, error)
+}
 
 type postBiz struct {
-	store store.IStore
+	store  store.IStore
+	search search.Index
+	opts   *genericoptions.PostOptions
 }
 
 var _ PostBiz = (*postBiz)(nil)
 
-func New(store store.IStore) *postBiz {
+func New(store store.IStore, search search.Index, opts *genericoptions.PostOptions) *postBiz {
 	return &postBiz{
-		store: store,
+		store:  store,
+		search: search,
+		opts:   opts,
 	}
 }
 
 func (b *postBiz) Create(ctx context.Context, rq *apiv1.CreatePostRequest) (*apiv1.CreatePostResponse, error) {
 	var postM model.Post
 	_ = copier.Copy(&postM, rq)
+	postM.UserID = contextx.UserID(ctx)
+
+	// 新建的博客默认为草稿，指定了发布时间的为定时发布
+	postM.Status = known.PostStatusDraft
+	if rq.PublishAt != nil {
+		postM.Status = known.PostStatusScheduled
+	}
+	postM.Visibility = known.PostVisibilityPrivate
+	if rq.Visibility != nil {
+		postM.Visibility = *rq.Visibility
+	}
+
+	// 博客、标签关联和第一个修订版本在同一个事务中写入
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.checkCategory(ctx, postM.CategoryID); err != nil {
+			return err
+		}
 
-	if err := b.store.Post().Create(ctx, &postM); err != nil {
+		if err := b.store.Post().Create(ctx, &postM); err != nil {
+			return err
+		}
+
+		if _, err := b.addRevision(ctx, &postM); err != nil {
+			return err
+		}
+
+		return b.setTags(ctx, postM.PostID, rq.Tags)
+	})
+	if err != nil {
 		return nil, err
 	}
+	b.indexPosts(ctx, &postM)
+	metrics.PostCreated()
 
 	return &apiv1.CreatePostResponse{
 		PostID: postM.PostID,
@@ -52,11 +118,19 @@ func (b *postBiz) Create(ctx context.Context, rq *apiv1.CreatePostRequest) (*api
 }
 
 func (b *postBiz) Update(ctx context.Context, rq *apiv1.UpdatePostRequest) (*apiv1.UpdatePostResponse, error) {
-	postM, err := b.store.Post().Get(ctx, where.F("userID", contextx.UserID(ctx), "postID", rq.PostID))
+	postM, err := b.store.Post().Get(ctx, ownerScope(ctx).F("postID", rq.PostID))
 	if err != nil {
 		return nil, err
 	}
 
+	// 客户端基于旧版本做出的修改会覆盖其他请求的修改，直接拒绝
+	if rq.Version != nil && *rq.Version != postM.Version {
+		return nil, errorsx.ErrVersionConflict
+	}
+
+	// 只有标题或内容发生变化时才需要生成新的修订版本
+	changed := (rq.Title != nil && *rq.Title != postM.Title) || (rq.Content != nil && *rq.Content != postM.Content)
+
 	if rq.Title != nil {
 		postM.Title = *rq.Title
 	}
@@ -65,54 +139,354 @@ func (b *postBiz) Update(ctx context.Context, rq *apiv1.UpdatePostRequest) (*api
 		postM.Content = *rq.Content
 	}
 
-	if err := b.store.Post().Update(ctx, postM); err != nil {
+	if rq.Visibility != nil {
+		postM.Visibility = *rq.Visibility
+	}
+
+	if rq.CategoryID != nil {
+		postM.CategoryID = *rq.CategoryID
+	}
+
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if rq.CategoryID != nil {
+			if err := b.checkCategory(ctx, postM.CategoryID); err != nil {
+				return err
+			}
+		}
+
+		if err := b.store.Post().Update(ctx, postM); err != nil {
+			return err
+		}
+
+		if changed {
+			if _, err := b.addRevision(ctx, postM); err != nil {
+				return err
+			}
+		}
+
+		if rq.Tags != nil {
+			return b.setTags(ctx, postM.PostID, *rq.Tags)
+		}
+
+		return nil
+	})
+	if err != nil {
 		return nil, err
 	}
+	b.indexPosts(ctx, postM)
 
-	return &apiv1.UpdatePostResponse{}, nil
+	return &apiv1.UpdatePostResponse{Version: postM.Version}, nil
 }
 
+// Delete 将当前用户有权删除的博客移入回收站.
+// 标签关联、评论和修订历史在博客被永久删除时才会清理，恢复博客后仍然可用.
 func (b *postBiz) Delete(ctx context.Context, rq *apiv1.DeletePostRequest) (*apiv1.DeletePostResponse, error) {
-	whr := where.F("userID", contextx.UserID(ctx), "postID", rq.PostIDs)
+	// 只从索引中删除当前用户有权删除的博客
+	_, postList, err := b.store.Post().List(ctx, ownerScope(ctx).F("postID", rq.PostIDs))
+	if err != nil {
+		return nil, err
+	}
 
-	if err := b.store.Post().Delete(ctx, whr); err != nil {
+	if err := b.store.Post().Delete(ctx, ownerScope(ctx).F("postID", rq.PostIDs)); err != nil {
 		return nil, err
 	}
 
+	postIDs := make([]string, 0, len(postList))
+	for _, item := range postList {
+		postIDs = append(postIDs, item.PostID)
+	}
+	b.unindexPosts(ctx, postIDs...)
+
 	return &apiv1.DeletePostResponse{}, nil
 }
 
 func (b *postBiz) Get(ctx context.Context, rq *apiv1.GetPostRequest) (*apiv1.GetPostResponse, error) {
-	postM, err := b.store.Post().Get(ctx, where.F("userID", contextx.UserID(ctx), "postID", rq.PostID))
+	postM, err := b.store.Post().Get(ctx, ownerScope(ctx).F("postID", rq.PostID))
+	if err != nil {
+		return nil, err
+	}
+
+	posts, err := b.toPostV1(ctx, postM)
 	if err != nil {
 		return nil, err
 	}
 
 	return &apiv1.GetPostResponse{
-		Post: conversion.PostodelToPostV1(postM),
+		Post: posts[0],
 	}, nil
 }
 
 func (b *postBiz) List(ctx context.Context, rq *apiv1.ListPostRequest) (*apiv1.ListPostResponse, error) {
-	whr := where.F("userID", contextx.UserID(ctx)).P(int(rq.Offset), int(rq.Limit))
+	whr := ownerScope(ctx)
 
 	if rq.Title != nil {
 		whr = whr.Q("title like ?", "%"+*rq.Title+"%")
 	}
 
-	count, postList, err := b.store.Post().List(ctx, whr)
+	if rq.Status != nil {
+		whr = whr.F("status", *rq.Status)
+	}
+
+	if tags := tagv1.NormalizeAll(rq.Tags); len(tags) > 0 {
+		subquery := "SELECT pt.postID FROM post_tag pt JOIN tag t ON t.id = pt.tagID WHERE t.name IN ?"
+		if rq.TagMode == known.TagModeAll {
+			// 包含所有标签：同一篇博客匹配到的标签数量等于过滤的标签数量
+			whr = whr.Q("postID IN ("+subquery+" GROUP BY pt.postID HAVING COUNT(DISTINCT t.id) = ?)", tags, len(tags))
+		} else {
+			whr = whr.Q("postID IN ("+subquery+")", tags)
+		}
+	}
 
+	if rq.CategoryID != nil {
+		categoryM, err := b.store.Category().Get(ctx, where.F("categoryID", *rq.CategoryID))
+		if err != nil {
+			return nil, err
+		}
+		// 分类路径是父分类路径的前缀，前缀匹配即可查出整个子树
+		whr = whr.Q("categoryID IN (SELECT categoryID FROM category WHERE path LIKE ?)", categoryM.Path+"%")
+	}
+
+	scope := conversion.PageScope("posts", rq.Title, rq.Status, rq.Tags, rq.TagMode, rq.CategoryID, rq.Filter)
+	page, err := conversion.ListRequestToOptions(whr, &rq.PageRequest, &rq.QueryRequest, store.PostSchema, scope)
 	if err != nil {
 		return nil, err
 	}
 
-	posts := make([]*apiv1.Post, 0, len(postList))
-	for _, item := range postList {
-		posts = append(posts, conversion.PostodelToPostV1(item))
+	ret, err := b.store.Post().Page(ctx, whr, page)
+	if err != nil {
+		return nil, err
+	}
+
+	posts, err := b.toPostV1(ctx, ret.Items...)
+	if err != nil {
+		return nil, err
 	}
 
 	return &apiv1.ListPostResponse{
-		TotalCount: count,
-		Posts:      posts,
+		TotalCount:    ret.Total,
+		Posts:         posts,
+		NextPageToken: conversion.NextPageToken(ret.Next, scope),
 	}, nil
 }
+
+// Publish 发布博客. 未指定发布时间或发布时间已到时立即发布，否则转为定时发布.
+func (b *postBiz) Publish(ctx context.Context, rq *apiv1.PublishPostRequest) (*apiv1.PublishPostResponse, error) {
+	postM, err := b.store.Post().Get(ctx, ownerScope(ctx).F("postID", rq.PostID))
+	if err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	published := false
+	if rq.PublishAt != nil && rq.PublishAt.After(now) {
+		postM.Status = known.PostStatusScheduled
+		postM.PublishAt = rq.PublishAt
+		postM.PublishedAt = nil
+	} else if postM.Status != known.PostStatusPublished {
+		postM.Status = known.PostStatusPublished
+		postM.PublishAt = nil
+		postM.PublishedAt = &now
+		published = true
+	}
+
+	if err := b.store.Post().Update(ctx, postM); err != nil {
+		return nil, err
+	}
+	if published {
+		metrics.PostsPublished(1)
+	}
+
+	posts, err := b.toPostV1(ctx, postM)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.PublishPostResponse{Post: posts[0]}, nil
+}
+
+// Unpublish 撤回博客，撤回后的博客改回草稿或者归档，同时取消尚未执行的定时发布.
+func (b *postBiz) Unpublish(ctx context.Context, rq *apiv1.UnpublishPostRequest) (*apiv1.UnpublishPostResponse, error) {
+	postM, err := b.store.Post().Get(ctx, ownerScope(ctx).F("postID", rq.PostID))
+	if err != nil {
+		return nil, err
+	}
+
+	postM.PublishAt = nil
+	if rq.Archive {
+		// 归档保留原来的发布时间
+		postM.Status = known.PostStatusArchived
+	} else {
+		postM.Status = known.PostStatusDraft
+		postM.PublishedAt = nil
+	}
+
+	if err := b.store.Post().Update(ctx, postM); err != nil {
+		return nil, err
+	}
+
+	posts, err := b.toPostV1(ctx, postM)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.UnpublishPostResponse{Post: posts[0]}, nil
+}
+
+// PublishScheduled 发布所有到期的定时发布博客.
+func (b *postBiz) PublishScheduled(ctx context.Context) (int64, error) {
+	count, err := b.store.Post().PublishDue(ctx, time.Now())
+	if err != nil {
+		return 0, err
+	}
+
+	if count > 0 {
+		slog.InfoContext(ctx, "Published scheduled posts", "count", count)
+		metrics.PostsPublished(count)
+	}
+
+	return count, nil
+}
+
+// ListPublic 返回已发布的公开博客，按照发布时间倒序排列.
+func (b *postBiz) ListPublic(ctx context.Context, rq *apiv1.ListPublicPostRequest) (*apiv1.ListPublicPostResponse, error) {
+	whr := where.F("status", known.PostStatusPublished, "visibility", known.PostVisibilityPublic)
+
+	if rq.Author != "" {
+		userM, err := b.store.User().Get(ctx, where.F("username", rq.Author))
+		if err != nil {
+			return nil, err
+		}
+		whr = whr.F("userID", userM.UserID)
+	}
+
+	scope := conversion.PageScope("public-posts", rq.Author, rq.Filter)
+	page, err := conversion.ListRequestToOptions(whr, &rq.PageRequest, &rq.QueryRequest, store.PublicPostSchema, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	ret, err := b.store.Post().Page(ctx, whr, page)
+	if err != nil {
+		return nil, err
+	}
+
+	authors, err := b.authorNames(ctx, ret.Items...)
+	if err != nil {
+		return nil, err
+	}
+
+	posts := make([]*apiv1.PublicPost, 0, len(ret.Items))
+	for _, item := range ret.Items {
+		posts = append(posts, conversion.PostModelToPublicPostV1(item, authors[item.UserID]))
+	}
+
+	return &apiv1.ListPublicPostResponse{
+		TotalCount:    ret.Total,
+		Posts:         posts,
+		NextPageToken: conversion.NextPageToken(ret.Next, scope),
+	}, nil
+}
+
+// GetPublic 返回已发布的博客. 不公开列出的博客不会出现在列表中，但知道博客 ID 的读者可以访问.
+func (b *postBiz) GetPublic(ctx context.Context, rq *apiv1.GetPublicPostRequest) (*apiv1.GetPublicPostResponse, error) {
+	whr := where.F(
+		"postID", rq.PostID,
+		"status", known.PostStatusPublished,
+		"visibility", []string{known.PostVisibilityPublic, known.PostVisibilityUnlisted},
+	)
+	postM, err := b.store.Post().Get(ctx, whr)
+	if err != nil {
+		return nil, err
+	}
+
+	authors, err := b.authorNames(ctx, postM)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.GetPublicPostResponse{
+		Post: conversion.PostModelToPublicPostV1(postM, authors[postM.UserID]),
+	}, nil
+}
+
+// authorNames 批量查询博客作者的用户名，返回用户 ID 到用户名的映射.
+func (b *postBiz) authorNames(ctx context.Context, posts ...*model.Post) (map[string]string, error) {
+	names := make(map[string]string, len(posts))
+	if len(posts) == 0 {
+		return names, nil
+	}
+
+	userIDs := make([]string, 0, len(posts))
+	for _, post := range posts {
+		userIDs = append(userIDs, post.UserID)
+	}
+
+	_, userList, err := b.store.User().List(ctx, where.F("userID", userIDs).L(len(userIDs)))
+	if err != nil {
+		return nil, err
+	}
+
+	for _, user := range userList {
+		names[user.UserID] = user.Username
+	}
+
+	return names, nil
+}
+
+// checkCategory 校验分类是否存在，空分类表示不设置分类.
+func (b *postBiz) checkCategory(ctx context.Context, categoryID string) error {
+	if categoryID == "" {
+		return nil
+	}
+
+	_, err := b.store.Category().Get(ctx, where.F("categoryID", categoryID))
+	return err
+}
+
+// setTags 将博客的标签替换为给定的标签，不存在的标签会被自动创建.
+func (b *postBiz) setTags(ctx context.Context, postID string, names []string) error {
+	tags, err := b.store.Tag().FindOrCreate(ctx, tagv1.NormalizeAll(names)...)
+	if err != nil {
+		return err
+	}
+
+	return b.store.PostTag().Replace(ctx, postID, tagv1.TagIDs(tags)...)
+}
+
+// toPostV1 将博客模型转换为 v1 博客对象，并批量填充博客的标签.
+func (b *postBiz) toPostV1(ctx context.Context, postList ...*model.Post) ([]*apiv1.Post, error) {
+	postIDs := make([]string, 0, len(postList))
+	for _, item := range postList {
+		postIDs = append(postIDs, item.PostID)
+	}
+
+	tags := map[string][]string{}
+	if len(postIDs) > 0 {
+		var err error
+		if tags, err = b.store.PostTag().TagNames(ctx, postIDs...); err != nil {
+			return nil, err
+		}
+	}
+
+	posts := make([]*apiv1.Post, 0, len(postList))
+	for _, item := range postList {
+		post := conversion.PostodelToPostV1(item)
+		post.Tags = tags[item.PostID]
+		if post.Tags == nil {
+			post.Tags = []string{}
+		}
+		posts = append(posts, post)
+	}
+
+	return posts, nil
+}
+
+// ownerScope 返回限定博客归属范围的查询条件.
+// 管理员可以访问所有用户的博客，普通用户只能访问自己的博客.
+func ownerScope(ctx context.Context) *where.Options {
+	if contextx.Role(ctx) == known.RoleAdmin {
+		return where.NewWhere()
+	}
+
+	return where.F("userID", contextx.UserID(ctx))
+}
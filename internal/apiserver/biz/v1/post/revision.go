@@ -0,0 +1,155 @@
+package post
+
+import (
+	"context"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+
+	"github.com/onexstack/fastgo/internal/apiserver/model"
+	"github.com/onexstack/fastgo/internal/apiserver/pkg/conversion"
+	"github.com/onexstack/fastgo/internal/apiserver/store"
+	"github.com/onexstack/fastgo/internal/pkg/contextx"
+	"github.com/onexstack/fastgo/internal/pkg/diff"
+	apiv1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+)
+
+// ListRevisions 返回博客的修订版本列表，按照版本号倒序排列.
+func (b *postBiz) ListRevisions(ctx context.Context, rq *apiv1.ListPostRevisionRequest) (*apiv1.ListPostRevisionResponse, error) {
+	if _, err := b.store.Post().Get(ctx, ownerScope(ctx).F("postID", rq.PostID)); err != nil {
+		return nil, err
+	}
+
+	whr := where.F("postID", rq.PostID)
+	scope := conversion.PageScope("post-revisions", rq.PostID, rq.Filter)
+	page, err := conversion.ListRequestToOptions(whr, &rq.PageRequest, &rq.QueryRequest, store.PostRevisionSchema, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	ret, err := b.store.PostRevision().Page(ctx, whr, page)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]*apiv1.PostRevision, 0, len(ret.Items))
+	for _, item := range ret.Items {
+		revision := conversion.PostRevisionModelToPostRevisionV1(item)
+		// 列表只用于浏览历史，内容可能很大，通过详情接口获取
+		revision.Content = ""
+		revisions = append(revisions, revision)
+	}
+
+	return &apiv1.ListPostRevisionResponse{
+		TotalCount:    ret.Total,
+		Revisions:     revisions,
+		NextPageToken: conversion.NextPageToken(ret.Next, scope),
+	}, nil
+}
+
+// GetRevision 返回博客的指定修订版本.
+func (b *postBiz) GetRevision(ctx context.Context, rq *apiv1.GetPostRevisionRequest) (*apiv1.GetPostRevisionResponse, error) {
+	revisionM, err := b.getRevision(ctx, rq.PostID, rq.Revision)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.GetPostRevisionResponse{
+		Revision: conversion.PostRevisionModelToPostRevisionV1(revisionM),
+	}, nil
+}
+
+// DiffRevisions 逐行比较博客的两个修订版本，标题和内容分别比较.
+func (b *postBiz) DiffRevisions(ctx context.Context, rq *apiv1.DiffPostRevisionRequest) (*apiv1.DiffPostRevisionResponse, error) {
+	fromM, err := b.getRevision(ctx, rq.PostID, rq.From)
+	if err != nil {
+		return nil, err
+	}
+
+	toM, err := b.getRevision(ctx, rq.PostID, rq.To)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.DiffPostRevisionResponse{
+		From:    rq.From,
+		To:      rq.To,
+		Title:   conversion.DiffLinesToDiffLinesV1(diff.Lines(fromM.Title, toM.Title)),
+		Content: conversion.DiffLinesToDiffLinesV1(diff.Lines(fromM.Content, toM.Content)),
+	}, nil
+}
+
+// RestoreRevision 将博客的标题和内容恢复为指定修订版本.
+// 恢复操作本身也会生成一个新的修订版本，恢复之前的内容仍然可以找回.
+func (b *postBiz) RestoreRevision(ctx context.Context, rq *apiv1.RestorePostRevisionRequest) (*apiv1.RestorePostRevisionResponse, error) {
+	var postM *model.Post
+	var revision int64
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		var err error
+		if postM, err = b.store.Post().Get(ctx, ownerScope(ctx).F("postID", rq.PostID)); err != nil {
+			return err
+		}
+
+		revisionM, err := b.store.PostRevision().Get(ctx, where.F("postID", rq.PostID, "revision", rq.Revision))
+		if err != nil {
+			return err
+		}
+
+		postM.Title = revisionM.Title
+		postM.Content = revisionM.Content
+		if err := b.store.Post().Update(ctx, postM); err != nil {
+			return err
+		}
+
+		revision, err = b.addRevision(ctx, postM)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+	b.indexPosts(ctx, postM)
+
+	posts, err := b.toPostV1(ctx, postM)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.RestorePostRevisionResponse{
+		Post:     posts[0],
+		Revision: revision,
+	}, nil
+}
+
+// getRevision 查询当前用户有权访问的博客的指定修订版本.
+func (b *postBiz) getRevision(ctx context.Context, postID string, revision int64) (*model.PostRevision, error) {
+	if _, err := b.store.Post().Get(ctx, ownerScope(ctx).F("postID", postID)); err != nil {
+		return nil, err
+	}
+
+	return b.store.PostRevision().Get(ctx, where.F("postID", postID, "revision", revision))
+}
+
+// addRevision 以博客当前的标题和内容写入一个新的修订版本，并清理超出数量上限的旧版本，返回新的版本号.
+// 调用方需要在修改博客的同一个事务中调用，保证博客内容和修订历史一致.
+func (b *postBiz) addRevision(ctx context.Context, postM *model.Post) (int64, error) {
+	latest, err := b.store.PostRevision().LatestRevision(ctx, postM.PostID)
+	if err != nil {
+		return 0, err
+	}
+
+	revisionM := &model.PostRevision{
+		PostID:   postM.PostID,
+		Revision: latest + 1,
+		UserID:   contextx.UserID(ctx),
+		Title:    postM.Title,
+		Content:  postM.Content,
+	}
+	if err := b.store.PostRevision().Create(ctx, revisionM); err != nil {
+		return 0, err
+	}
+
+	if err := b.store.PostRevision().Prune(ctx, postM.PostID, b.opts.MaxRevisions); err != nil {
+		return 0, err
+	}
+
+	return revisionM.Revision, nil
+}
@@ -0,0 +1,110 @@
+package post
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/onexstack/fastgo/internal/apiserver/model"
+	"github.com/onexstack/fastgo/internal/apiserver/pkg/conversion"
+	"github.com/onexstack/fastgo/internal/apiserver/search"
+	"github.com/onexstack/fastgo/internal/pkg/contextx"
+	"github.com/onexstack/fastgo/internal/pkg/errorsx"
+	"github.com/onexstack/fastgo/internal/pkg/known"
+	"github.com/onexstack/fastgo/internal/pkg/pagination"
+	"github.com/onexstack/fastgo/internal/pkg/tracing"
+	apiv1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+)
+
+// searchSortBy 是检索结果分页令牌中的排序标识，检索结果总是按照相关度倒序排列.
+const searchSortBy = "_score"
+
+// Search 在当前用户有权查看的博客中检索关键词，按照相关度倒序返回博客和高亮片段.
+func (b *postBiz) Search(ctx context.Context, rq *apiv1.SearchPostRequest) (*apiv1.SearchPostResponse, error) {
+	scope := conversion.PageScope("posts/search", rq.Q)
+	srq := &search.Request{Query: rq.Q, Limit: int(rq.Limit)}
+	if srq.Limit <= 0 {
+		srq.Limit = known.DefaultPageSize
+	}
+	if contextx.Role(ctx) != known.RoleAdmin {
+		srq.UserID = contextx.UserID(ctx)
+	}
+
+	// 相关度评分没有稳定的 keyset 位置，分页令牌中记录的是下一页的偏移量
+	if rq.PageToken != "" {
+		token, err := pagination.Decode(rq.PageToken)
+		if err != nil || token.SortBy != searchSortBy || token.Scope != pagination.Scope(scope) {
+			return nil, errorsx.ErrInvalidPageToken
+		}
+		srq.Offset = token.Offset
+	}
+
+	// 检索索引不经过 GORM，单独创建 span 记录检索耗时
+	searchCtx, span := tracing.Start(ctx, "search.Search")
+	res, err := b.search.Search(searchCtx, srq)
+	tracing.RecordError(span, err)
+	span.End()
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to search posts", "err", err, "q", rq.Q)
+		return nil, errorsx.ErrPostSearch
+	}
+
+	postIDs := make([]string, 0, len(res.Hits))
+	for _, hit := range res.Hits {
+		postIDs = append(postIDs, hit.PostID)
+	}
+
+	// 博客内容以数据库为准，索引只提供匹配的博客 ID、评分和高亮片段
+	_, postList, err := b.store.Post().List(ctx, ownerScope(ctx).F("postID", postIDs))
+	if err != nil {
+		return nil, err
+	}
+	posts, err := b.toPostV1(ctx, postList...)
+	if err != nil {
+		return nil, err
+	}
+	postMap := make(map[string]*apiv1.Post, len(posts))
+	for _, post := range posts {
+		postMap[post.PostID] = post
+	}
+
+	results := make([]*apiv1.PostSearchResult, 0, len(res.Hits))
+	for _, hit := range res.Hits {
+		post, ok := postMap[hit.PostID]
+		if !ok {
+			// 索引中残留的已删除博客，跳过即可
+			slog.WarnContext(ctx, "Post in search index not found in database", "postID", hit.PostID)
+			continue
+		}
+		results = append(results, &apiv1.PostSearchResult{
+			Post:       post,
+			Score:      hit.Score,
+			Highlights: hit.Highlights,
+		})
+	}
+
+	var next *pagination.Token
+	if offset := srq.Offset + len(res.Hits); int64(offset) < res.Total && len(res.Hits) > 0 {
+		next = &pagination.Token{SortBy: searchSortBy, Offset: offset}
+	}
+
+	return &apiv1.SearchPostResponse{
+		TotalCount:    res.Total,
+		Results:       results,
+		NextPageToken: conversion.NextPageToken(next, scope),
+	}, nil
+}
+
+// indexPosts 将博客的最新内容写入全文索引.
+// 索引是数据库的派生数据，写入失败时只记录日志，不影响已经完成的数据库操作.
+func (b *postBiz) indexPosts(ctx context.Context, posts ...*model.Post) {
+	if err := b.search.Index(ctx, posts...); err != nil {
+		slog.ErrorContext(ctx, "Failed to index posts, search results may be stale", "err", err)
+	}
+}
+
+// unindexPosts 从全文索引中删除博客，失败时只记录日志.
+func (b *postBiz) unindexPosts(ctx context.Context, postIDs ...string) {
+	if err := b.search.Delete(ctx, postIDs...); err != nil {
+		slog.ErrorContext(ctx, "Failed to remove posts from search index, search results may be stale", "err", err, "postIDs", postIDs)
+	}
+}
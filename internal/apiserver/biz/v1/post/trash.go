@@ -0,0 +1,121 @@
+package post
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+
+	"github.com/onexstack/fastgo/internal/apiserver/pkg/conversion"
+	"github.com/onexstack/fastgo/internal/apiserver/store"
+	"github.com/onexstack/fastgo/internal/pkg/errorsx"
+	"github.com/onexstack/fastgo/internal/pkg/known"
+	apiv1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+)
+
+// ListTrash 返回当前用户回收站中的博客，管理员可以查看所有用户的博客.
+func (b *postBiz) ListTrash(ctx context.Context, rq *apiv1.ListTrashPostRequest) (*apiv1.ListTrashPostResponse, error) {
+	whr := ownerScope(ctx)
+	scope := conversion.PageScope("trashed-posts", rq.Filter)
+	page, err := conversion.ListRequestToOptions(whr, &rq.PageRequest, &rq.QueryRequest, store.TrashedPostSchema, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	ret, err := b.store.Post().PageTrashed(ctx, whr, page)
+	if err != nil {
+		return nil, err
+	}
+
+	posts, err := b.toPostV1(ctx, ret.Items...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.ListTrashPostResponse{
+		TotalCount:    ret.Total,
+		Posts:         posts,
+		NextPageToken: conversion.NextPageToken(ret.Next, scope),
+	}, nil
+}
+
+// Restore 将博客从回收站中恢复. 博客所属的分类如果已被删除，恢复后的博客不再属于任何分类.
+func (b *postBiz) Restore(ctx context.Context, rq *apiv1.RestorePostRequest) (*apiv1.RestorePostResponse, error) {
+	count, err := b.store.Post().Restore(ctx, ownerScope(ctx).F("postID", rq.PostID))
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return nil, errorsx.ErrPostNotFound
+	}
+
+	postM, err := b.store.Post().Get(ctx, where.F("postID", rq.PostID))
+	if err != nil {
+		return nil, err
+	}
+
+	if b.checkCategory(ctx, postM.CategoryID) != nil {
+		postM.CategoryID = ""
+		if err := b.store.Post().Update(ctx, postM); err != nil {
+			return nil, err
+		}
+	}
+	b.indexPosts(ctx, postM)
+
+	posts, err := b.toPostV1(ctx, postM)
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.RestorePostResponse{Post: posts[0]}, nil
+}
+
+// PurgeTrash 分批永久删除过期的回收站博客，以及博客的标签关联、评论和修订历史.
+func (b *postBiz) PurgeTrash(ctx context.Context, before time.Time) (int64, error) {
+	var total int64
+	for {
+		_, postList, err := b.store.Post().ListTrashed(ctx, where.L(known.TrashPurgeBatchSize).Q("deletedAt < ?", before))
+		if err != nil {
+			return total, err
+		}
+		if len(postList) == 0 {
+			break
+		}
+
+		postIDs := make([]string, 0, len(postList))
+		for _, item := range postList {
+			postIDs = append(postIDs, item.PostID)
+		}
+
+		err = b.store.TX(ctx, func(ctx context.Context) error {
+			if err := b.store.PostTag().DeleteByPosts(ctx, postIDs...); err != nil {
+				return err
+			}
+
+			if err := b.store.Comment().Delete(ctx, where.F("postID", postIDs)); err != nil {
+				return err
+			}
+
+			if err := b.store.PostRevision().Delete(ctx, where.F("postID", postIDs)); err != nil {
+				return err
+			}
+
+			return b.store.Post().Purge(ctx, where.F("postID", postIDs))
+		})
+		if err != nil {
+			return total, err
+		}
+
+		total += int64(len(postList))
+		if len(postList) < known.TrashPurgeBatchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		slog.InfoContext(ctx, "Purged trashed posts", "count", total)
+	}
+
+	return total, nil
+}
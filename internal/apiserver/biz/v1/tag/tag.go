@@ -0,0 +1,172 @@
+package tag
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+
+	"github.com/onexstack/fastgo/internal/apiserver/model"
+	"github.com/onexstack/fastgo/internal/apiserver/pkg/conversion"
+	"github.com/onexstack/fastgo/internal/apiserver/store"
+	"github.com/onexstack/fastgo/internal/pkg/errorsx"
+	apiv1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+)
+
+// TagBiz 定义处理标签请求所需的方法.
+type TagBiz interface {
+	List(ctx context.Context, rq *apiv1.ListTagRequest) (*apiv1.ListTagResponse, error)
+	Rename(ctx context.Context, rq *apiv1.RenameTagRequest) (*apiv1.RenameTagResponse, error)
+	Merge(ctx context.Context, rq *apiv1.MergeTagRequest) (*apiv1.MergeTagResponse, error)
+
+	TagExpansion
+}
+
+// TagExpansion 定义额外的标签操作方法.
+type TagExpansion interface{}
+
+type tagBiz struct {
+	store store.IStore
+}
+
+var _ TagBiz = (*tagBiz)(nil)
+
+func New(store store.IStore) *tagBiz {
+	return &tagBiz{
+		store: store,
+	}
+}
+
+// List 分页返回标签及其关联的博客数量.
+func (b *tagBiz) List(ctx context.Context, rq *apiv1.ListTagRequest) (*apiv1.ListTagResponse, error) {
+	whr := where.NewWhere()
+	scope := conversion.PageScope("tags", rq.Filter)
+	page, err := conversion.ListRequestToOptions(whr, &rq.PageRequest, &rq.QueryRequest, store.TagSchema, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	ret, err := b.store.Tag().Page(ctx, whr, page)
+	if err != nil {
+		return nil, err
+	}
+
+	postCounts, err := b.store.Tag().PostCounts(ctx, TagIDs(ret.Items)...)
+	if err != nil {
+		return nil, err
+	}
+
+	tags := make([]*apiv1.Tag, 0, len(ret.Items))
+	for _, item := range ret.Items {
+		tags = append(tags, &apiv1.Tag{Name: item.Name, PostCount: postCounts[item.ID]})
+	}
+
+	return &apiv1.ListTagResponse{
+		TotalCount:    ret.Total,
+		Tags:          tags,
+		NextPageToken: conversion.NextPageToken(ret.Next, scope),
+	}, nil
+}
+
+// Rename 重命名标签，所有关联该标签的博客会同时生效.
+func (b *tagBiz) Rename(ctx context.Context, rq *apiv1.RenameTagRequest) (*apiv1.RenameTagResponse, error) {
+	tagM, err := b.store.Tag().Get(ctx, where.F("name", Normalize(rq.Name)))
+	if err != nil {
+		return nil, err
+	}
+
+	newName := Normalize(rq.NewName)
+	if newName == tagM.Name {
+		return &apiv1.RenameTagResponse{}, nil
+	}
+
+	_, err = b.store.Tag().Get(ctx, where.F("name", newName))
+	if err == nil {
+		return nil, errorsx.ErrTagAlreadyExists
+	}
+	if !errors.Is(err, errorsx.ErrTagNotFound) {
+		return nil, err
+	}
+
+	// 博客返回的标签名称随之改变，同时更新关联博客的版本号
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		tagM.Name = newName
+		if err := b.store.Tag().Update(ctx, tagM); err != nil {
+			return err
+		}
+
+		return b.store.PostTag().TouchPosts(ctx, tagM.ID)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.RenameTagResponse{}, nil
+}
+
+// Merge 将多个标签合并为一个标签，原来关联这些标签的博客会改为关联目标标签.
+func (b *tagBiz) Merge(ctx context.Context, rq *apiv1.MergeTagRequest) (*apiv1.MergeTagResponse, error) {
+	target := Normalize(rq.Target)
+	sources := slices.DeleteFunc(NormalizeAll(rq.Sources), func(name string) bool { return name == target })
+
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		_, sourceList, err := b.store.Tag().List(ctx, where.F("name", sources))
+		if err != nil {
+			return err
+		}
+		if len(sourceList) != len(sources) {
+			return errorsx.ErrTagNotFound
+		}
+
+		targetList, err := b.store.Tag().FindOrCreate(ctx, target)
+		if err != nil {
+			return err
+		}
+
+		sourceIDs := TagIDs(sourceList)
+		// 在改为关联目标标签之前更新版本号，此时还能根据原来的标签找到受影响的博客
+		if err := b.store.PostTag().TouchPosts(ctx, sourceIDs...); err != nil {
+			return err
+		}
+		if err := b.store.PostTag().Reassign(ctx, sourceIDs, targetList[0].ID); err != nil {
+			return err
+		}
+
+		return b.store.Tag().Delete(ctx, where.F("id", sourceIDs))
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.MergeTagResponse{}, nil
+}
+
+// Normalize 规范化标签名称：去掉首尾空白并转为小写，避免出现只有大小写不同的重复标签.
+func Normalize(name string) string {
+	return strings.ToLower(strings.TrimSpace(name))
+}
+
+// NormalizeAll 规范化标签名称列表，并去掉空的和重复的标签.
+func NormalizeAll(names []string) []string {
+	ret := make([]string, 0, len(names))
+	for _, name := range names {
+		name = Normalize(name)
+		if name != "" && !slices.Contains(ret, name) {
+			ret = append(ret, name)
+		}
+	}
+
+	return ret
+}
+
+// TagIDs 返回标签的 ID 列表.
+func TagIDs(tags []*model.Tag) []int64 {
+	ids := make([]int64, 0, len(tags))
+	for _, tag := range tags {
+		ids = append(ids, tag.ID)
+	}
+
+	return ids
+}
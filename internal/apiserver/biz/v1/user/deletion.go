@@ -0,0 +1,130 @@
+package user
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+
+	"github.com/onexstack/fastgo/internal/apiserver/model"
+	"github.com/onexstack/fastgo/internal/pkg/known"
+	apiv1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+)
+
+// CancelDeletion 取消删除账号，宽限期结束后账号已被删除，无法再取消.
+func (b *userBiz) CancelDeletion(ctx context.Context, rq *apiv1.CancelUserDeletionRequest) (*apiv1.CancelUserDeletionResponse, error) {
+	userID, err := targetUserID(ctx, rq.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	userM, err := b.store.User().Get(ctx, where.F("UserID", userID))
+	if err != nil {
+		return nil, err
+	}
+
+	if userM.DeletionScheduledAt != nil {
+		userM.DeletionScheduledAt = nil
+		if err := b.store.User().Update(ctx, userM); err != nil {
+			return nil, err
+		}
+	}
+
+	return &apiv1.CancelUserDeletionResponse{}, nil
+}
+
+// DeleteScheduled 分批删除宽限期已结束的账号，每个账号在单独的事务中删除，一个账号失败不影响其他账号.
+func (b *userBiz) DeleteScheduled(ctx context.Context) (int64, error) {
+	var total int64
+	for {
+		_, userList, err := b.store.User().List(ctx, where.L(known.TrashPurgeBatchSize).Q("deletionScheduledAt <= ?", time.Now()))
+		if err != nil {
+			return total, err
+		}
+
+		var deleted int
+		for _, userM := range userList {
+			if err := b.deleteAccount(ctx, userM); err != nil {
+				slog.ErrorContext(ctx, "Failed to delete account", "err", err, "userID", userM.UserID)
+				continue
+			}
+			deleted++
+		}
+
+		total += int64(deleted)
+		// 本批全部失败时停止，避免反复处理同一批账号
+		if len(userList) < known.TrashPurgeBatchSize || deleted == 0 {
+			break
+		}
+	}
+
+	if total > 0 {
+		slog.InfoContext(ctx, "Deleted scheduled accounts", "count", total)
+	}
+
+	return total, nil
+}
+
+// deleteAccount 在一个事务中删除账号及其所有数据：
+//   - 删除用户的所有博客（包括回收站中的博客）及其标签关联、评论和修订历史；
+//   - 匿名化用户在其他博客下发表的评论，保留评论树的结构；
+//   - 删除用户的所有刷新令牌，使所有会话失效；
+//   - 将用户移入回收站，回收站保留期内用户名不会被重新注册，之后由 PurgeTrash 永久删除.
+//
+// 事务提交后再从全文索引中删除用户的博客.
+func (b *userBiz) deleteAccount(ctx context.Context, userM *model.User) error {
+	var postIDs []string
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		_, postList, err := b.store.Post().List(ctx, where.F("userID", userM.UserID))
+		if err != nil {
+			return err
+		}
+		_, trashedList, err := b.store.Post().ListTrashed(ctx, where.F("userID", userM.UserID))
+		if err != nil {
+			return err
+		}
+
+		postIDs = make([]string, 0, len(postList)+len(trashedList))
+		for _, item := range append(postList, trashedList...) {
+			postIDs = append(postIDs, item.PostID)
+		}
+
+		if len(postIDs) > 0 {
+			if err := b.store.PostTag().DeleteByPosts(ctx, postIDs...); err != nil {
+				return err
+			}
+
+			if err := b.store.Comment().Delete(ctx, where.F("postID", postIDs)); err != nil {
+				return err
+			}
+
+			if err := b.store.PostRevision().Delete(ctx, where.F("postID", postIDs)); err != nil {
+				return err
+			}
+
+			if err := b.store.Post().Purge(ctx, where.F("postID", postIDs)); err != nil {
+				return err
+			}
+		}
+
+		if err := b.store.Comment().Anonymize(ctx, where.F("userID", userM.UserID)); err != nil {
+			return err
+		}
+
+		if err := b.store.RefreshToken().Delete(ctx, where.F("userID", userM.UserID)); err != nil {
+			return err
+		}
+
+		return b.store.User().Delete(ctx, where.F("userID", userM.UserID))
+	})
+	if err != nil {
+		return err
+	}
+
+	if err := b.search.Delete(ctx, postIDs...); err != nil {
+		slog.ErrorContext(ctx, "Failed to remove posts from search index, search results may be stale", "err", err, "userID", userM.UserID)
+	}
+
+	return nil
+}
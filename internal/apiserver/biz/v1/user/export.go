@@ -0,0 +1,103 @@
+package user
+
+import (
+	"context"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+
+	"github.com/onexstack/fastgo/internal/apiserver/model"
+	"github.com/onexstack/fastgo/internal/apiserver/pkg/conversion"
+	apiv1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+)
+
+// Export 导出用户的个人信息、所有博客（包括回收站中的博客及其修订历史）和发表的评论.
+func (b *userBiz) Export(ctx context.Context, rq *apiv1.ExportUserRequest) (*apiv1.ExportUserResponse, error) {
+	userID, err := targetUserID(ctx, rq.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	userM, err := b.store.User().Get(ctx, where.F("UserID", userID))
+	if err != nil {
+		return nil, err
+	}
+
+	_, postList, err := b.store.Post().List(ctx, where.F("userID", userM.UserID))
+	if err != nil {
+		return nil, err
+	}
+	_, trashedList, err := b.store.Post().ListTrashed(ctx, where.F("userID", userM.UserID))
+	if err != nil {
+		return nil, err
+	}
+	postList = append(postList, trashedList...)
+
+	posts, err := b.exportPosts(ctx, postList)
+	if err != nil {
+		return nil, err
+	}
+
+	// 已删除的评论不再包含用户数据
+	_, commentList, err := b.store.Comment().List(ctx, where.F("userID", userM.UserID).Q("deletedAt IS NULL"))
+	if err != nil {
+		return nil, err
+	}
+
+	comments := make([]*apiv1.Comment, 0, len(commentList))
+	for _, item := range commentList {
+		comments = append(comments, conversion.CommentModelToCommentV1(item))
+	}
+
+	return &apiv1.ExportUserResponse{
+		ExportedAt: time.Now(),
+		User:       conversion.UserodelToUserV1(userM),
+		Posts:      posts,
+		Comments:   comments,
+	}, nil
+}
+
+// exportPosts 批量查询博客的标签和修订历史，转换为导出数据中的博客.
+func (b *userBiz) exportPosts(ctx context.Context, postList []*model.Post) ([]*apiv1.ExportedPost, error) {
+	posts := make([]*apiv1.ExportedPost, 0, len(postList))
+	if len(postList) == 0 {
+		return posts, nil
+	}
+
+	postIDs := make([]string, 0, len(postList))
+	for _, item := range postList {
+		postIDs = append(postIDs, item.PostID)
+	}
+
+	tags, err := b.store.PostTag().TagNames(ctx, postIDs...)
+	if err != nil {
+		return nil, err
+	}
+
+	_, revisionList, err := b.store.PostRevision().List(ctx, where.F("postID", postIDs))
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make(map[string][]*apiv1.PostRevision, len(postList))
+	for _, item := range revisionList {
+		revisions[item.PostID] = append(revisions[item.PostID], conversion.PostRevisionModelToPostRevisionV1(item))
+	}
+
+	for _, item := range postList {
+		post := apiv1.ExportedPost{
+			Post:      *conversion.PostodelToPostV1(item),
+			Revisions: revisions[item.PostID],
+		}
+		post.Tags = tags[item.PostID]
+		if post.Tags == nil {
+			post.Tags = []string{}
+		}
+		if post.Revisions == nil {
+			post.Revisions = []*apiv1.PostRevision{}
+		}
+		posts = append(posts, &post)
+	}
+
+	return posts, nil
+}
@@ -0,0 +1,103 @@
+package user
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+
+	"github.com/onexstack/fastgo/internal/apiserver/pkg/conversion"
+	"github.com/onexstack/fastgo/internal/apiserver/store"
+	"github.com/onexstack/fastgo/internal/pkg/contextx"
+	"github.com/onexstack/fastgo/internal/pkg/errorsx"
+	"github.com/onexstack/fastgo/internal/pkg/known"
+	apiv1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+)
+
+// ListTrash 返回回收站中的用户.
+// 授权策略已经限制只有管理员可以访问，这里再校验一次角色作为纵深防御.
+func (b *userBiz) ListTrash(ctx context.Context, rq *apiv1.ListTrashUserRequest) (*apiv1.ListTrashUserResponse, error) {
+	if contextx.Role(ctx) != known.RoleAdmin {
+		return nil, errorsx.ErrPermissionDenied
+	}
+
+	whr := where.NewWhere()
+	scope := conversion.PageScope("trashed-users", rq.Filter)
+	page, err := conversion.ListRequestToOptions(whr, &rq.PageRequest, &rq.QueryRequest, store.TrashedUserSchema, scope)
+	if err != nil {
+		return nil, err
+	}
+
+	ret, err := b.store.User().PageTrashed(ctx, whr, page)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*apiv1.User, 0, len(ret.Items))
+	for _, item := range ret.Items {
+		users = append(users, conversion.UserodelToUserV1(item))
+	}
+
+	return &apiv1.ListTrashUserResponse{
+		TotalCount:    ret.Total,
+		Users:         users,
+		NextPageToken: conversion.NextPageToken(ret.Next, scope),
+	}, nil
+}
+
+// Restore 将用户从回收站中恢复，恢复后用户可以重新登录.
+func (b *userBiz) Restore(ctx context.Context, rq *apiv1.RestoreUserRequest) (*apiv1.RestoreUserResponse, error) {
+	if contextx.Role(ctx) != known.RoleAdmin {
+		return nil, errorsx.ErrPermissionDenied
+	}
+
+	count, err := b.store.User().Restore(ctx, where.F("userID", rq.UserID))
+	if err != nil {
+		return nil, err
+	}
+	if count == 0 {
+		return nil, errorsx.ErrUserNotFound
+	}
+
+	userM, err := b.store.User().Get(ctx, where.F("userID", rq.UserID))
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.RestoreUserResponse{User: conversion.UserodelToUserV1(userM)}, nil
+}
+
+// PurgeTrash 分批永久删除过期的回收站用户.
+func (b *userBiz) PurgeTrash(ctx context.Context, before time.Time) (int64, error) {
+	var total int64
+	for {
+		_, userList, err := b.store.User().ListTrashed(ctx, where.L(known.TrashPurgeBatchSize).Q("deletedAt < ?", before))
+		if err != nil {
+			return total, err
+		}
+		if len(userList) == 0 {
+			break
+		}
+
+		userIDs := make([]string, 0, len(userList))
+		for _, item := range userList {
+			userIDs = append(userIDs, item.UserID)
+		}
+
+		if err := b.store.User().Purge(ctx, where.F("userID", userIDs)); err != nil {
+			return total, err
+		}
+
+		total += int64(len(userList))
+		if len(userList) < known.TrashPurgeBatchSize {
+			break
+		}
+	}
+
+	if total > 0 {
+		slog.InfoContext(ctx, "Purged trashed users", "count", total)
+	}
+
+	return total, nil
+}
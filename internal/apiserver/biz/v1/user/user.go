@@ -2,20 +2,29 @@ package user
 
 import (
 	"context"
+	"errors"
 	"log/slog"
 	"sync"
+	"time"
 
+	"github.com/google/uuid"
 	"github.com/jinzhu/copier"
 	"github.com/onexstack/fastgo/internal/apiserver/model"
 	"github.com/onexstack/fastgo/internal/apiserver/pkg/conversion"
+	"github.com/onexstack/fastgo/internal/apiserver/search"
 	"github.com/onexstack/fastgo/internal/apiserver/store"
 	"github.com/onexstack/fastgo/internal/pkg/contextx"
 	"github.com/onexstack/fastgo/internal/pkg/errorsx"
 	"github.com/onexstack/fastgo/internal/pkg/known"
+	"github.com/onexstack/fastgo/internal/pkg/metrics"
+	"github.com/onexstack/fastgo/internal/pkg/tracing"
 	apiv1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
 	"github.com/onexstack/fastgo/pkg/auth"
+	genericoptions "github.com/onexstack/fastgo/pkg/options"
 	"github.com/onexstack/fastgo/pkg/token"
 	"github.com/onexstack/onexstack/pkg/store/where"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/sync/errgroup"
 )
 
@@ -34,26 +43,47 @@ type UserBiz interface {
 type UserExpansion interface {
 	Login(ctx context.Context, rq *apiv1.LoginRequest) (*apiv1.LoginResponse, error)
 	RefreshToken(ctx context.Context, rq *apiv1.RefreshTokenRequest) (*apiv1.RefreshTokenResponse, error)
+	Logout(ctx context.Context, rq *apiv1.LogoutRequest) (*apiv1.LogoutResponse, error)
+	LogoutAll(ctx context.Context, rq *apiv1.LogoutAllRequest) (*apiv1.LogoutAllResponse, error)
 	ChangePassword(ctx context.Context, rq *apiv1.ChangePasswordRequest) (*apiv1.ChangePasswordResponse, error)
+	UpdateRole(ctx context.Context, rq *apiv1.UpdateUserRoleRequest) (*apiv1.UpdateUserRoleResponse, error)
+	// ListTrash 返回回收站中的用户，仅管理员可以调用.
+	ListTrash(ctx context.Context, rq *apiv1.ListTrashUserRequest) (*apiv1.ListTrashUserResponse, error)
+	// Restore 将用户从回收站中恢复，仅管理员可以调用.
+	Restore(ctx context.Context, rq *apiv1.RestoreUserRequest) (*apiv1.RestoreUserResponse, error)
+	// PurgeTrash 永久删除在 before 之前移入回收站的用户，由后台任务定期调用.
+	PurgeTrash(ctx context.Context, before time.Time) (int64, error)
+	// CancelDeletion 在宽限期内取消删除账号.
+	CancelDeletion(ctx context.Context, rq *apiv1.CancelUserDeletionRequest) (*apiv1.CancelUserDeletionResponse, error)
+	// DeleteScheduled 删除所有宽限期已结束的账号，由后台任务定期调用.
+	DeleteScheduled(ctx context.Context) (int64, error)
+	// Export 导出用户的所有个人数据.
+	Export(ctx context.Context, rq *apiv1.ExportUserRequest) (*apiv1.ExportUserResponse, error)
 }
 
 var _ UserBiz = (*userBiz)(nil)
 
 type userBiz struct {
-	store store.IStore
+	store  store.IStore
+	search search.Index
+	opts   *genericoptions.UserOptions
 }
 
-func New(store store.IStore) *userBiz {
-	return &userBiz{store: store}
+func New(store store.IStore, search search.Index, opts *genericoptions.UserOptions) *userBiz {
+	return &userBiz{store: store, search: search, opts: opts}
 }
 
 func (b *userBiz) Create(ctx context.Context, rq *apiv1.CreateUserRequest) (*apiv1.CreateUserResponse, error) {
 	var userM model.User
 	_ = copier.Copy(&userM, rq)
 
+	// 注册的用户总是普通用户，管理员角色只能由管理员通过 UpdateUserRole 授予
+	userM.Role = known.RoleUser
+
 	if err := b.store.User().Create(ctx, &userM); err != nil {
 		return nil, err
 	}
+	metrics.UserCreated()
 
 	return &apiv1.CreateUserResponse{
 		UserID: userM.UserID,
@@ -61,11 +91,21 @@ func (b *userBiz) Create(ctx context.Context, rq *apiv1.CreateUserRequest) (*api
 }
 
 func (b *userBiz) Update(ctx context.Context, rq *apiv1.UpdateUserRequest) (*apiv1.UpdateUserResponse, error) {
-	userM, err := b.store.User().Get(ctx, where.F("UserID", contextx.UserID(ctx)))
+	userID, err := targetUserID(ctx, rq.UserID)
 	if err != nil {
 		return nil, err
 	}
 
+	userM, err := b.store.User().Get(ctx, where.F("UserID", userID))
+	if err != nil {
+		return nil, err
+	}
+
+	// 客户端基于旧版本做出的修改会覆盖其他请求的修改，直接拒绝
+	if rq.Version != nil && *rq.Version != userM.Version {
+		return nil, errorsx.ErrVersionConflict
+	}
+
 	if rq.Username != nil {
 		userM.Username = *rq.Username
 	}
@@ -86,19 +126,41 @@ func (b *userBiz) Update(ctx context.Context, rq *apiv1.UpdateUserRequest) (*api
 		return nil, err
 	}
 
-	return &apiv1.UpdateUserResponse{}, nil
+	return &apiv1.UpdateUserResponse{Version: userM.Version}, nil
 }
 
+// Delete 申请删除账号. 账号不会立即删除，宽限期内用户仍然可以登录、导出数据或取消删除，
+// 宽限期结束后由后台任务删除账号及其所有数据，见 DeleteScheduled.
 func (b *userBiz) Delete(ctx context.Context, rq *apiv1.DeleteUserRequest) (*apiv1.DeleteUserResponse, error) {
-	if err := b.store.User().Delete(ctx, where.F("UserID", contextx.UserID(ctx))); err != nil {
+	userID, err := targetUserID(ctx, rq.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	userM, err := b.store.User().Get(ctx, where.F("UserID", userID))
+	if err != nil {
 		return nil, err
 	}
 
-	return &apiv1.DeleteUserResponse{}, nil
+	// 重复申请不会推迟已经计划的删除时间
+	if userM.DeletionScheduledAt == nil {
+		deletionScheduledAt := time.Now().Add(b.opts.DeletionGracePeriod)
+		userM.DeletionScheduledAt = &deletionScheduledAt
+		if err := b.store.User().Update(ctx, userM); err != nil {
+			return nil, err
+		}
+	}
+
+	return &apiv1.DeleteUserResponse{DeletionScheduledAt: *userM.DeletionScheduledAt}, nil
 }
 
 func (b *userBiz) Get(ctx context.Context, rq *apiv1.GetUserRequest) (*apiv1.GetUserResponse, error) {
-	userM, err := b.store.User().Get(ctx, where.F("UserID", contextx.UserID(ctx)))
+	userID, err := targetUserID(ctx, rq.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	userM, err := b.store.User().Get(ctx, where.F("UserID", userID))
 	if err != nil {
 		return nil, err
 	}
@@ -109,15 +171,27 @@ func (b *userBiz) Get(ctx context.Context, rq *apiv1.GetUserRequest) (*apiv1.Get
 }
 
 func (b *userBiz) List(ctx context.Context, rq *apiv1.ListUserRequest) (*apiv1.ListUserResponse, error) {
-	whr := where.P(int(rq.Offset), int(rq.Limit))
-	count, userList, err := b.store.User().List(ctx, whr)
+	ctx, span := tracing.Start(ctx, "userBiz.List")
+	defer span.End()
+
+	whr := where.NewWhere()
+	scope := conversion.PageScope("users", rq.Filter)
+	page, err := conversion.ListRequestToOptions(whr, &rq.PageRequest, &rq.QueryRequest, store.UserSchema, scope)
+	if err != nil {
+		return nil, err
+	}
 
+	ret, err := b.store.User().Page(ctx, whr, page)
 	if err != nil {
 		return nil, err
 	}
+	userList := ret.Items
+
+	// 单独记录并发查询博客数量的耗时，与分页查询用户的耗时区分开
+	fanoutCtx, fanoutSpan := tracing.Start(ctx, "userBiz.List.countPosts", trace.WithAttributes(attribute.Int("users", len(userList))))
 
 	var m sync.Map
-	eg, ctx := errgroup.WithContext(ctx)
+	eg, ctx := errgroup.WithContext(fanoutCtx)
 
 	// 设置最大并发数量为常量 MaxConcurrency
 	eg.SetLimit(known.MaxErrGroupConcurrency)
@@ -129,7 +203,7 @@ func (b *userBiz) List(ctx context.Context, rq *apiv1.ListUserRequest) (*apiv1.L
 			case <-ctx.Done():
 				return nil
 			default:
-				count, _, err := b.store.Post().List(ctx, where.F("userID", contextx.UserID(ctx)))
+				count, _, err := b.store.Post().List(ctx, where.F("userID", user.UserID))
 				if err != nil {
 					return err
 				}
@@ -144,7 +218,10 @@ func (b *userBiz) List(ctx context.Context, rq *apiv1.ListUserRequest) (*apiv1.L
 	}
 
 	// 等待所有 goroutine 完成
-	if err := eg.Wait(); err != nil {
+	err = eg.Wait()
+	tracing.RecordError(fanoutSpan, err)
+	fanoutSpan.End()
+	if err != nil {
 		slog.ErrorContext(ctx, "Failed to wait all function calls returned", "err", err)
 		return nil, err
 	}
@@ -157,45 +234,203 @@ func (b *userBiz) List(ctx context.Context, rq *apiv1.ListUserRequest) (*apiv1.L
 
 	slog.DebugContext(ctx, "Get users from backend storage", "count", len(users))
 
-	return &apiv1.ListUserResponse{TotalCount: count, Users: users}, nil
+	return &apiv1.ListUserResponse{TotalCount: ret.Total, Users: users, NextPageToken: conversion.NextPageToken(ret.Next, scope)}, nil
 }
 
 func (b *userBiz) Login(ctx context.Context, rq *apiv1.LoginRequest) (*apiv1.LoginResponse, error) {
 	userM, err := b.store.User().Get(ctx, where.F("username", rq.Username))
 	if err != nil {
+		metrics.LoginFailed()
 		return nil, errorsx.ErrUserNotFound
 	}
 
 	if err := auth.Compare(userM.Password, rq.Password); err != nil {
+		metrics.LoginFailed()
 		return nil, errorsx.ErrPasswordInvalid
 	}
 
-	// 如果匹配成功，说明登录成功，签发 token 并返回
-	tokenStr, expireAt, err := token.Sign(userM.UserID)
+	// 如果匹配成功，说明登录成功，为本次登录创建新的会话并签发 token
+	sessionID := uuid.New().String()
+	refreshToken, refreshExpireAt, err := b.issueRefreshToken(ctx, userM.UserID, sessionID)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenStr, expireAt, err := token.Sign(userM.UserID, sessionID)
 	if err != nil {
 		return nil, errorsx.ErrSignToken
 	}
+	metrics.LoginSucceeded()
 
 	return &apiv1.LoginResponse{
-		Token:    tokenStr,
-		ExpireAt: expireAt,
+		UserID:          userM.UserID,
+		Token:           tokenStr,
+		ExpireAt:        expireAt,
+		RefreshToken:    refreshToken,
+		RefreshExpireAt: refreshExpireAt,
 	}, nil
 }
 
+// RefreshToken 使用刷新令牌换取新的 token，并轮换刷新令牌.
+// 每个刷新令牌只能使用一次，已轮换的刷新令牌再次被使用说明可能已经泄露，此时吊销整个会话.
 func (b *userBiz) RefreshToken(ctx context.Context, rq *apiv1.RefreshTokenRequest) (*apiv1.RefreshTokenResponse, error) {
-	// 如果匹配成功，说明登录成功，签发 token 并返回
-	tokenStr, expireAt, err := token.Sign(contextx.UserID(ctx))
+	rtM, err := b.store.RefreshToken().Get(ctx, where.F("tokenHash", token.HashRefreshToken(rq.RefreshToken)))
 	if err != nil {
-		return nil, errorsx.ErrSignToken
+		return nil, err
 	}
 
-	return &apiv1.RefreshTokenResponse{
-		Token:    tokenStr,
-		ExpireAt: expireAt,
-	}, nil
+	if time.Now().After(rtM.ExpiresAt) {
+		return nil, errorsx.ErrRefreshTokenInvalid
+	}
+
+	var resp apiv1.RefreshTokenResponse
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		// 只有尚未吊销的刷新令牌才能被轮换，并发使用同一个刷新令牌时只有一个请求能成功
+		revoked, err := b.store.RefreshToken().Revoke(ctx, where.F("id", rtM.ID))
+		if err != nil {
+			return err
+		}
+		if revoked == 0 {
+			return errorsx.ErrRefreshTokenReused
+		}
+
+		resp.RefreshToken, resp.RefreshExpireAt, err = b.issueRefreshToken(ctx, rtM.UserID, rtM.SessionID)
+		if err != nil {
+			return err
+		}
+
+		resp.Token, resp.ExpireAt, err = token.Sign(rtM.UserID, rtM.SessionID)
+		if err != nil {
+			return errorsx.ErrSignToken
+		}
+
+		return nil
+	})
+	if errors.Is(err, errorsx.ErrRefreshTokenReused) {
+		// 会话已经通过退出登录等方式关闭时，刷新令牌只是失效，不属于重复使用
+		if _, err := b.store.RefreshToken().Get(ctx, where.F("sessionID", rtM.SessionID).Q("revokedAt IS NULL")); err != nil {
+			return nil, err
+		}
+
+		slog.WarnContext(ctx, "Refresh token reuse detected, revoking session", "userID", rtM.UserID, "sessionID", rtM.SessionID)
+		if err := b.revokeSessions(ctx, rtM.SessionID); err != nil {
+			return nil, err
+		}
+		return nil, errorsx.ErrRefreshTokenReused
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &resp, nil
+}
+
+// Logout 退出当前会话，当前会话的 token 和刷新令牌立即失效.
+func (b *userBiz) Logout(ctx context.Context, rq *apiv1.LogoutRequest) (*apiv1.LogoutResponse, error) {
+	err := b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.revokeCurrentToken(ctx); err != nil {
+			return err
+		}
+
+		if sessionID := contextx.SessionID(ctx); sessionID != "" {
+			return b.revokeSessions(ctx, sessionID)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.LogoutResponse{}, nil
+}
+
+// LogoutAll 退出当前用户的所有会话，所有已签发的 token 和刷新令牌立即失效.
+func (b *userBiz) LogoutAll(ctx context.Context, rq *apiv1.LogoutAllRequest) (*apiv1.LogoutAllResponse, error) {
+	_, rtList, err := b.store.RefreshToken().List(ctx, where.F("userID", contextx.UserID(ctx)).Q("revokedAt IS NULL"))
+	if err != nil {
+		return nil, err
+	}
+
+	sessionIDs := make([]string, 0, len(rtList)+1)
+	for _, rt := range rtList {
+		sessionIDs = append(sessionIDs, rt.SessionID)
+	}
+	if sessionID := contextx.SessionID(ctx); sessionID != "" {
+		sessionIDs = append(sessionIDs, sessionID)
+	}
+
+	err = b.store.TX(ctx, func(ctx context.Context) error {
+		if err := b.revokeCurrentToken(ctx); err != nil {
+			return err
+		}
+
+		return b.revokeSessions(ctx, sessionIDs...)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &apiv1.LogoutAllResponse{}, nil
 }
 
+// issueRefreshToken 为指定会话签发新的刷新令牌，数据库中只保存其哈希值.
+func (b *userBiz) issueRefreshToken(ctx context.Context, userID string, sessionID string) (string, time.Time, error) {
+	raw, hash, expireAt, err := token.NewRefreshToken()
+	if err != nil {
+		return "", time.Time{}, errorsx.ErrSignToken
+	}
+
+	if err := b.store.RefreshToken().Create(ctx, &model.RefreshToken{
+		UserID:    userID,
+		SessionID: sessionID,
+		TokenHash: hash,
+		ExpiresAt: expireAt,
+	}); err != nil {
+		return "", time.Time{}, err
+	}
+
+	return raw, expireAt, nil
+}
+
+// revokeCurrentToken 将当前请求使用的 token 加入吊销列表.
+func (b *userBiz) revokeCurrentToken(ctx context.Context) error {
+	tokenID := contextx.TokenID(ctx)
+	if tokenID == "" {
+		return nil
+	}
+
+	return b.store.RevokedToken().Create(ctx, &model.RevokedToken{TokenID: tokenID, ExpiresAt: time.Now().Add(token.Expiration())})
+}
+
+// revokeSessions 吊销指定会话的所有刷新令牌，并将会话 ID 加入吊销列表，使这些会话已签发的 token 立即失效.
+func (b *userBiz) revokeSessions(ctx context.Context, sessionIDs ...string) error {
+	if len(sessionIDs) == 0 {
+		return nil
+	}
+
+	if _, err := b.store.RefreshToken().Revoke(ctx, where.F("sessionID", sessionIDs)); err != nil {
+		return err
+	}
+
+	// 会话中最后签发的 token 最晚在 token 过期时间后失效，吊销记录只需保留这么长时间
+	expiresAt := time.Now().Add(token.Expiration())
+	for _, sessionID := range sessionIDs {
+		if err := b.store.RevokedToken().Create(ctx, &model.RevokedToken{TokenID: sessionID, ExpiresAt: expiresAt}); err != nil {
+			return err
+		}
+	}
+
+	// 顺便清理已过期的吊销记录，避免吊销列表无限增长
+	return b.store.RevokedToken().DeleteExpired(ctx)
+}
+
+// ChangePassword 修改当前用户的密码. 修改密码需要提供旧密码，所以管理员也只能修改自己的密码.
 func (b *userBiz) ChangePassword(ctx context.Context, rq *apiv1.ChangePasswordRequest) (*apiv1.ChangePasswordResponse, error) {
+	if rq.UserID != "" && rq.UserID != contextx.UserID(ctx) {
+		return nil, errorsx.ErrPermissionDenied
+	}
+
 	userM, err := b.store.User().Get(ctx, where.F("UserID", contextx.UserID(ctx)))
 	if err != nil {
 		return nil, err
@@ -216,3 +451,30 @@ func (b *userBiz) ChangePassword(ctx context.Context, rq *apiv1.ChangePasswordRe
 
 	return &apiv1.ChangePasswordResponse{}, nil
 }
+
+func (b *userBiz) UpdateRole(ctx context.Context, rq *apiv1.UpdateUserRoleRequest) (*apiv1.UpdateUserRoleResponse, error) {
+	userM, err := b.store.User().Get(ctx, where.F("UserID", rq.UserID))
+	if err != nil {
+		return nil, err
+	}
+
+	userM.Role = rq.Role
+	if err := b.store.User().Update(ctx, userM); err != nil {
+		return nil, err
+	}
+
+	return &apiv1.UpdateUserRoleResponse{}, nil
+}
+
+// targetUserID 返回请求要操作的用户 ID，userID 为空时操作当前用户.
+// 管理员可以通过 userID 操作任意用户，普通用户指定其他用户时返回 ErrPermissionDenied.
+func targetUserID(ctx context.Context, userID string) (string, error) {
+	if userID == "" || userID == contextx.UserID(ctx) {
+		return contextx.UserID(ctx), nil
+	}
+	if contextx.Role(ctx) != known.RoleAdmin {
+		return "", errorsx.ErrPermissionDenied
+	}
+
+	return userID, nil
+}
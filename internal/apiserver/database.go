@@ -0,0 +1,100 @@
+package apiserver
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/onexstack/fastgo/internal/apiserver/migration"
+	"github.com/onexstack/fastgo/internal/apiserver/model"
+	"github.com/onexstack/fastgo/internal/apiserver/store"
+	"github.com/onexstack/fastgo/internal/pkg/known"
+	genericoptions "github.com/onexstack/fastgo/pkg/options"
+)
+
+// NewDB 根据 db.driver 配置创建对应存储后端的 *gorm.DB 实例.
+func (cfg *Config) NewDB() (*gorm.DB, error) {
+	switch cfg.DBOptions.Driver {
+	case genericoptions.DriverSQLite:
+		return cfg.SQLiteOptions.NewDB()
+	case genericoptions.DriverMemory:
+		return genericoptions.NewMemoryOptions().NewDB()
+	default:
+		return cfg.MySQLOptions.NewDB()
+	}
+}
+
+// ensureSchema 确保数据库表结构是最新的.
+// 开启 db.auto-migrate 或使用内存数据库时自动执行未执行的迁移，否则存在未执行的迁移时拒绝启动.
+func (cfg *Config) ensureSchema(db *gorm.DB) error {
+	migrator, err := migration.New(db)
+	if err != nil {
+		return err
+	}
+
+	ctx := context.Background()
+
+	// 内存数据库每次启动都是空库，无法通过 migrate 子命令提前迁移，所以总是自动迁移
+	if cfg.DBOptions.AutoMigrate || cfg.DBOptions.Driver == genericoptions.DriverMemory {
+		applied, err := migrator.Up(ctx)
+		for _, m := range applied {
+			slog.Info("Applied database migration", "version", m.Version, "name", m.Name)
+		}
+
+		return err
+	}
+
+	pending, err := migrator.Pending(ctx)
+	if err != nil {
+		return err
+	}
+
+	if len(pending) > 0 {
+		return fmt.Errorf("database has %d pending migration(s), run `fg-apiserver migrate up` first or set db.auto-migrate to true", len(pending))
+	}
+
+	return nil
+}
+
+// ensureAdmin 在系统中还没有管理员时，根据 user.admin-username 和 user.admin-password 创建初始管理员.
+// 同名用户已存在时不会授予其管理员角色，否则任何人都可以抢先注册该用户名获得管理员权限.
+func (cfg *Config) ensureAdmin(ctx context.Context, ds store.IStore) error {
+	opts := cfg.UserOptions
+	if opts.AdminPassword == "" {
+		return nil
+	}
+
+	count, _, err := ds.User().List(ctx, where.F("role", known.RoleAdmin).L(1))
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		return nil
+	}
+
+	count, _, err = ds.User().List(ctx, where.F("username", opts.AdminUsername).L(1))
+	if err != nil {
+		return err
+	}
+	if count > 0 {
+		slog.Warn("User already exists and will not be granted the admin role, grant it manually or configure another user.admin-username",
+			"username", opts.AdminUsername)
+		return nil
+	}
+
+	admin := &model.User{
+		Username: opts.AdminUsername,
+		Password: opts.AdminPassword,
+		Nickname: opts.AdminUsername,
+		Role:     known.RoleAdmin,
+	}
+	if err := ds.User().Create(ctx, admin); err != nil {
+		return err
+	}
+
+	slog.Info("Created initial admin user", "username", admin.Username, "userID", admin.UserID)
+	return nil
+}
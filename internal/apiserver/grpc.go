@@ -0,0 +1,65 @@
+package apiserver
+
+import (
+	"net/http"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/reflection"
+
+	"github.com/onexstack/fastgo/internal/apiserver/biz"
+	grpchandler "github.com/onexstack/fastgo/internal/apiserver/handler/grpc"
+	"github.com/onexstack/fastgo/internal/apiserver/pkg/authz"
+	"github.com/onexstack/fastgo/internal/apiserver/pkg/conversion/validation"
+	"github.com/onexstack/fastgo/internal/apiserver/search"
+	"github.com/onexstack/fastgo/internal/apiserver/store"
+	grpcmw "github.com/onexstack/fastgo/internal/pkg/middleware/grpc"
+	"github.com/onexstack/fastgo/pkg/api/apiserver/v1/pb"
+)
+
+// grpcPublicMethods 是无需认证和授权的 gRPC 方法，与 REST API 中不经过 Authn 的接口相同.
+var grpcPublicMethods = []string{
+	pb.UserService_Login_FullMethodName,
+	pb.UserService_RefreshToken_FullMethodName,
+	pb.UserService_CreateUser_FullMethodName,
+}
+
+// grpcRoutes 定义了 gRPC 方法对应的 REST 路由，授权时使用 REST 路由匹配授权策略.
+// 新增 gRPC 方法时需要同时补充这里，否则非公开方法会被拒绝访问.
+var grpcRoutes = map[string]grpcmw.Route{
+	pb.UserService_UpdateUser_FullMethodName:     {Method: http.MethodPut, Path: "/v1/users/:userID"},
+	pb.UserService_DeleteUser_FullMethodName:     {Method: http.MethodDelete, Path: "/v1/users/:userID"},
+	pb.UserService_GetUser_FullMethodName:        {Method: http.MethodGet, Path: "/v1/users/:userID"},
+	pb.UserService_ListUser_FullMethodName:       {Method: http.MethodGet, Path: "/v1/users"},
+	pb.UserService_ChangePassword_FullMethodName: {Method: http.MethodPut, Path: "/v1/users/:userID/change-password"},
+
+	pb.PostService_CreatePost_FullMethodName:    {Method: http.MethodPost, Path: "/v1/posts"},
+	pb.PostService_UpdatePost_FullMethodName:    {Method: http.MethodPut, Path: "/v1/posts/:postID"},
+	pb.PostService_DeletePost_FullMethodName:    {Method: http.MethodDelete, Path: "/v1/posts"},
+	pb.PostService_GetPost_FullMethodName:       {Method: http.MethodGet, Path: "/v1/posts/:postID"},
+	pb.PostService_ListPost_FullMethodName:      {Method: http.MethodGet, Path: "/v1/posts"},
+	pb.PostService_PublishPost_FullMethodName:   {Method: http.MethodPost, Path: "/v1/posts/:postID/publish"},
+	pb.PostService_UnpublishPost_FullMethodName: {Method: http.MethodPost, Path: "/v1/posts/:postID/unpublish"},
+	pb.PostService_SearchPost_FullMethodName:    {Method: http.MethodGet, Path: "/v1/posts/search"},
+}
+
+// NewGRPCServer 创建 gRPC 服务，gRPC 接口与 REST API 共用相同的 Biz 层、参数校验和授权策略.
+func (cfg *Config) NewGRPCServer(store store.IStore, index search.Index) *grpc.Server {
+	srv := grpc.NewServer(grpc.ChainUnaryInterceptor(
+		grpcmw.Recovery(),
+		grpcmw.Tracing(),
+		grpcmw.RequestID(),
+		grpcmw.AccessLog(),
+		// 先认证再授权：Authn 解析出用户 ID，Authz 根据用户角色和授权策略判断是否可以调用当前方法
+		grpcmw.Authn(grpcPublicMethods...),
+		grpcmw.Authz(authz.New(store), grpcRoutes, grpcPublicMethods...),
+	))
+
+	handler := grpchandler.NewHandler(biz.NewBiz(store, index, cfg.UserOptions, cfg.PostOptions), validation.NewValidator(store))
+	pb.RegisterUserServiceServer(srv, handler)
+	pb.RegisterPostServiceServer(srv, handler)
+
+	// 注册反射服务，便于使用 grpcurl 等工具调试
+	reflection.Register(srv)
+
+	return srv
+}
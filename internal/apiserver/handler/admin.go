@@ -0,0 +1,44 @@
+package handler
+
+import (
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/onexstack/fastgo/internal/pkg/core"
+	"github.com/onexstack/fastgo/internal/pkg/log"
+	v1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+	"github.com/onexstack/onexstack/pkg/errorsx"
+)
+
+// GetLogLevel 查询当前实例的日志级别.
+func (h *Handler) GetLogLevel(c *gin.Context) {
+	slog.InfoContext(c.Request.Context(), "Get log level function called")
+
+	level, levels := log.GetLevel()
+	core.WriteResponse(c, &v1.GetLogLevelResponse{Level: level, Levels: levels}, nil)
+}
+
+// UpdateLogLevel 修改当前实例的日志级别，无需重启服务.
+func (h *Handler) UpdateLogLevel(c *gin.Context) {
+	slog.InfoContext(c.Request.Context(), "Update log level function called")
+
+	var rq v1.UpdateLogLevelRequest
+	if err := c.ShouldBindJSON(&rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrBind)
+		return
+	}
+
+	if err := h.val.ValidateUpdateLogLevelRequest(c, &rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error()))
+		return
+	}
+
+	if err := log.SetLevel(rq.Level, rq.Levels); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error()))
+		return
+	}
+
+	level, levels := log.GetLevel()
+	slog.WarnContext(c.Request.Context(), "Log level changed", "level", level, "levels", levels)
+	core.WriteResponse(c, &v1.UpdateLogLevelResponse{Level: level, Levels: levels}, nil)
+}
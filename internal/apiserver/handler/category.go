@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/onexstack/fastgo/internal/pkg/core"
+	v1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+	"github.com/onexstack/onexstack/pkg/errorsx"
+)
+
+func (h *Handler) CreateCategory(c *gin.Context) {
+	slog.InfoContext(c.Request.Context(), "Create category function called")
+
+	var rq v1.CreateCategoryRequest
+	if err := c.ShouldBindJSON(&rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrBind)
+		return
+	}
+
+	if err := h.val.ValidateCreateCategoryRequest(c, &rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error()))
+		return
+	}
+
+	resp, err := h.biz.CategoryV1().Create(c.Request.Context(), &rq)
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	core.WriteResponse(c, resp, nil)
+}
+
+func (h *Handler) DeleteCategory(c *gin.Context) {
+	slog.InfoContext(c.Request.Context(), "Delete category function called")
+
+	var rq v1.DeleteCategoryRequest
+	if err := c.ShouldBindUri(&rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrBind)
+		return
+	}
+
+	if err := h.val.ValidateDeleteCategoryRequest(c, &rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error()))
+		return
+	}
+
+	resp, err := h.biz.CategoryV1().Delete(c.Request.Context(), &rq)
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	core.WriteResponse(c, resp, nil)
+}
+
+func (h *Handler) ListCategory(c *gin.Context) {
+	slog.InfoContext(c.Request.Context(), "List category function called")
+
+	var rq v1.ListCategoryRequest
+	if err := c.ShouldBindQuery(&rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrBind)
+		return
+	}
+
+	if err := h.val.ValidateListCategoryRequest(c, &rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error()))
+		return
+	}
+
+	resp, err := h.biz.CategoryV1().List(c.Request.Context(), &rq)
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	writeListResponse(c, resp, rq.Fields)
+}
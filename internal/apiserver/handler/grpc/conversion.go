@@ -0,0 +1,65 @@
+package grpc
+
+import (
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/core"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	apiv1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+	"github.com/onexstack/fastgo/pkg/api/apiserver/v1/pb"
+)
+
+// timestampToTime 将可选的 Timestamp 转换为 *time.Time.
+func timestampToTime(ts *timestamppb.Timestamp) *time.Time {
+	if ts == nil {
+		return nil
+	}
+	t := ts.AsTime()
+	return &t
+}
+
+// timeToTimestamp 将可选的 *time.Time 转换为 Timestamp.
+func timeToTimestamp(t *time.Time) *timestamppb.Timestamp {
+	if t == nil {
+		return nil
+	}
+	return timestamppb.New(*t)
+}
+
+// userV1ToPB 将 v1 用户对象转换为 gRPC 用户对象.
+// CopyWithConverters 只能转换 time.Time，*time.Time 类型的可选时间字段需要单独转换.
+func userV1ToPB(user *apiv1.User) *pb.User {
+	if user == nil {
+		return nil
+	}
+
+	var pbUser pb.User
+	_ = core.CopyWithConverters(&pbUser, user)
+	pbUser.DeletionScheduledAt = timeToTimestamp(user.DeletionScheduledAt)
+	pbUser.DeletedAt = timeToTimestamp(user.DeletedAt)
+	return &pbUser
+}
+
+// postV1ToPB 将 v1 博客对象转换为 gRPC 博客对象.
+func postV1ToPB(post *apiv1.Post) *pb.Post {
+	if post == nil {
+		return nil
+	}
+
+	var pbPost pb.Post
+	_ = core.CopyWithConverters(&pbPost, post)
+	pbPost.PublishAt = timeToTimestamp(post.PublishAt)
+	pbPost.PublishedAt = timeToTimestamp(post.PublishedAt)
+	pbPost.DeletedAt = timeToTimestamp(post.DeletedAt)
+	return &pbPost
+}
+
+// postsV1ToPB 将 v1 博客列表转换为 gRPC 博客列表.
+func postsV1ToPB(posts []*apiv1.Post) []*pb.Post {
+	pbPosts := make([]*pb.Post, 0, len(posts))
+	for _, post := range posts {
+		pbPosts = append(pbPosts, postV1ToPB(post))
+	}
+	return pbPosts
+}
@@ -0,0 +1,29 @@
+package grpc
+
+import (
+	"github.com/onexstack/fastgo/internal/apiserver/biz"
+	"github.com/onexstack/fastgo/internal/apiserver/pkg/conversion/validation"
+	"github.com/onexstack/fastgo/pkg/api/apiserver/v1/pb"
+)
+
+// Handler 实现了 gRPC 的用户服务和博客服务，与 REST API 的 Handler 共用相同的 Biz 层和参数校验.
+type Handler struct {
+	pb.UnimplementedUserServiceServer
+	pb.UnimplementedPostServiceServer
+
+	biz biz.IBiz
+	val *validation.Validator
+}
+
+// 确保 Handler 实现了 gRPC 服务接口.
+var (
+	_ pb.UserServiceServer = (*Handler)(nil)
+	_ pb.PostServiceServer = (*Handler)(nil)
+)
+
+func NewHandler(biz biz.IBiz, val *validation.Validator) *Handler {
+	return &Handler{
+		biz: biz,
+		val: val,
+	}
+}
@@ -0,0 +1,179 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/onexstack/fastgo/internal/pkg/errorsx"
+	apiv1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+	"github.com/onexstack/fastgo/pkg/api/apiserver/v1/pb"
+)
+
+func (h *Handler) CreatePost(ctx context.Context, rq *pb.CreatePostRequest) (*pb.CreatePostResponse, error) {
+	slog.InfoContext(ctx, "Create post function called")
+
+	v1rq := &apiv1.CreatePostRequest{
+		Title:      rq.GetTitle(),
+		Content:    rq.GetContent(),
+		CategoryID: rq.GetCategoryId(),
+		Tags:       rq.GetTags(),
+		Visibility: rq.Visibility,
+		PublishAt:  timestampToTime(rq.GetPublishAt()),
+	}
+	if err := h.val.ValidateCreatePostRequest(ctx, v1rq); err != nil {
+		return nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error())
+	}
+
+	resp, err := h.biz.PostV1().Create(ctx, v1rq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreatePostResponse{PostId: resp.PostID}, nil
+}
+
+func (h *Handler) UpdatePost(ctx context.Context, rq *pb.UpdatePostRequest) (*pb.UpdatePostResponse, error) {
+	slog.InfoContext(ctx, "Update post function called")
+
+	// version 与 REST API 的 If-Match 请求头相同，只有版本号一致才允许更新
+	v1rq := &apiv1.UpdatePostRequest{
+		PostID:     rq.GetPostId(),
+		Title:      rq.Title,
+		Content:    rq.Content,
+		CategoryID: rq.CategoryId,
+		Visibility: rq.Visibility,
+		Version:    rq.Version,
+	}
+	// 未设置 tags 时保留原有标签，设置为空列表时清空标签
+	if rq.GetTags() != nil {
+		tags := rq.GetTags().GetNames()
+		v1rq.Tags = &tags
+	}
+	if err := h.val.ValidateUpdatePostRequest(ctx, v1rq); err != nil {
+		return nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error())
+	}
+
+	resp, err := h.biz.PostV1().Update(ctx, v1rq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdatePostResponse{Version: resp.Version}, nil
+}
+
+func (h *Handler) DeletePost(ctx context.Context, rq *pb.DeletePostRequest) (*pb.DeletePostResponse, error) {
+	slog.InfoContext(ctx, "Delete post function called")
+
+	v1rq := &apiv1.DeletePostRequest{PostIDs: rq.GetPostIds()}
+	if err := h.val.ValidateDeletePostRequest(ctx, v1rq); err != nil {
+		return nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error())
+	}
+
+	if _, err := h.biz.PostV1().Delete(ctx, v1rq); err != nil {
+		return nil, err
+	}
+
+	return &pb.DeletePostResponse{}, nil
+}
+
+func (h *Handler) GetPost(ctx context.Context, rq *pb.GetPostRequest) (*pb.GetPostResponse, error) {
+	slog.InfoContext(ctx, "Get post function called")
+
+	v1rq := &apiv1.GetPostRequest{PostID: rq.GetPostId()}
+	if err := h.val.ValidateGetPostRequest(ctx, v1rq); err != nil {
+		return nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error())
+	}
+
+	resp, err := h.biz.PostV1().Get(ctx, v1rq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetPostResponse{Post: postV1ToPB(resp.Post)}, nil
+}
+
+func (h *Handler) ListPost(ctx context.Context, rq *pb.ListPostRequest) (*pb.ListPostResponse, error) {
+	slog.InfoContext(ctx, "List post function called")
+
+	v1rq := &apiv1.ListPostRequest{
+		PageRequest: apiv1.PageRequest{
+			PageToken: rq.GetPageToken(),
+			Limit:     rq.GetLimit(),
+			SortBy:    rq.GetSortBy(),
+			Order:     rq.GetOrder(),
+			WithTotal: rq.GetWithTotal(),
+		},
+		QueryRequest: apiv1.QueryRequest{Filter: rq.GetFilter(), OrderBy: rq.GetOrderBy()},
+		Title:        rq.Title,
+		Status:       rq.Status,
+		Tags:         rq.GetTags(),
+		TagMode:      rq.GetTagMode(),
+		CategoryID:   rq.CategoryId,
+	}
+	if err := h.val.ValidateListPostRequest(ctx, v1rq); err != nil {
+		return nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error())
+	}
+
+	resp, err := h.biz.PostV1().List(ctx, v1rq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.ListPostResponse{TotalCount: resp.TotalCount, Posts: postsV1ToPB(resp.Posts), NextPageToken: resp.NextPageToken}, nil
+}
+
+func (h *Handler) PublishPost(ctx context.Context, rq *pb.PublishPostRequest) (*pb.PublishPostResponse, error) {
+	slog.InfoContext(ctx, "Publish post function called")
+
+	v1rq := &apiv1.PublishPostRequest{PostID: rq.GetPostId(), PublishAt: timestampToTime(rq.GetPublishAt())}
+	if err := h.val.ValidatePublishPostRequest(ctx, v1rq); err != nil {
+		return nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error())
+	}
+
+	resp, err := h.biz.PostV1().Publish(ctx, v1rq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.PublishPostResponse{Post: postV1ToPB(resp.Post)}, nil
+}
+
+func (h *Handler) UnpublishPost(ctx context.Context, rq *pb.UnpublishPostRequest) (*pb.UnpublishPostResponse, error) {
+	slog.InfoContext(ctx, "Unpublish post function called")
+
+	v1rq := &apiv1.UnpublishPostRequest{PostID: rq.GetPostId(), Archive: rq.GetArchive()}
+	if err := h.val.ValidateUnpublishPostRequest(ctx, v1rq); err != nil {
+		return nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error())
+	}
+
+	resp, err := h.biz.PostV1().Unpublish(ctx, v1rq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UnpublishPostResponse{Post: postV1ToPB(resp.Post)}, nil
+}
+
+func (h *Handler) SearchPost(ctx context.Context, rq *pb.SearchPostRequest) (*pb.SearchPostResponse, error) {
+	slog.InfoContext(ctx, "Search post function called")
+
+	v1rq := &apiv1.SearchPostRequest{Q: rq.GetQ(), PageToken: rq.GetPageToken(), Limit: rq.GetLimit()}
+	if err := h.val.ValidateSearchPostRequest(ctx, v1rq); err != nil {
+		return nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error())
+	}
+
+	resp, err := h.biz.PostV1().Search(ctx, v1rq)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*pb.PostSearchResult, 0, len(resp.Results))
+	for _, result := range resp.Results {
+		highlights := make(map[string]*pb.Fragments, len(result.Highlights))
+		for field, fragments := range result.Highlights {
+			highlights[field] = &pb.Fragments{Fragments: fragments}
+		}
+		results = append(results, &pb.PostSearchResult{Post: postV1ToPB(result.Post), Score: result.Score, Highlights: highlights})
+	}
+	return &pb.SearchPostResponse{TotalCount: resp.TotalCount, Results: results, NextPageToken: resp.NextPageToken}, nil
+}
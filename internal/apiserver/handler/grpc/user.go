@@ -0,0 +1,170 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+
+	"github.com/onexstack/onexstack/pkg/core"
+
+	"github.com/onexstack/fastgo/internal/pkg/errorsx"
+	apiv1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+	"github.com/onexstack/fastgo/pkg/api/apiserver/v1/pb"
+)
+
+func (h *Handler) Login(ctx context.Context, rq *pb.LoginRequest) (*pb.LoginResponse, error) {
+	slog.InfoContext(ctx, "Login function called")
+
+	v1rq := &apiv1.LoginRequest{Username: rq.GetUsername(), Password: rq.GetPassword()}
+	if err := h.val.ValidateLoginRequest(ctx, v1rq); err != nil {
+		return nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error())
+	}
+
+	resp, err := h.biz.UserV1().Login(ctx, v1rq)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbResp pb.LoginResponse
+	_ = core.CopyWithConverters(&pbResp, resp)
+	return &pbResp, nil
+}
+
+func (h *Handler) RefreshToken(ctx context.Context, rq *pb.RefreshTokenRequest) (*pb.RefreshTokenResponse, error) {
+	slog.InfoContext(ctx, "Refresh token function called")
+
+	v1rq := &apiv1.RefreshTokenRequest{RefreshToken: rq.GetRefreshToken()}
+	if err := h.val.ValidateRefreshTokenRequest(ctx, v1rq); err != nil {
+		return nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error())
+	}
+
+	resp, err := h.biz.UserV1().RefreshToken(ctx, v1rq)
+	if err != nil {
+		return nil, err
+	}
+
+	var pbResp pb.RefreshTokenResponse
+	_ = core.CopyWithConverters(&pbResp, resp)
+	return &pbResp, nil
+}
+
+func (h *Handler) ChangePassword(ctx context.Context, rq *pb.ChangePasswordRequest) (*pb.ChangePasswordResponse, error) {
+	slog.InfoContext(ctx, "Change password function called")
+
+	v1rq := &apiv1.ChangePasswordRequest{OldPassword: rq.GetOldPassword(), NewPassword: rq.GetNewPassword()}
+	if err := h.val.ValidateChangePasswordRequest(ctx, v1rq); err != nil {
+		return nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error())
+	}
+
+	if _, err := h.biz.UserV1().ChangePassword(ctx, v1rq); err != nil {
+		return nil, err
+	}
+
+	return &pb.ChangePasswordResponse{}, nil
+}
+
+func (h *Handler) CreateUser(ctx context.Context, rq *pb.CreateUserRequest) (*pb.CreateUserResponse, error) {
+	slog.InfoContext(ctx, "Create user function called")
+
+	v1rq := &apiv1.CreateUserRequest{
+		Username: rq.GetUsername(),
+		Password: rq.GetPassword(),
+		Nickname: rq.Nickname,
+		Email:    rq.GetEmail(),
+		Phone:    rq.GetPhone(),
+	}
+	if err := h.val.ValidateCreateUserRequest(ctx, v1rq); err != nil {
+		return nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error())
+	}
+
+	resp, err := h.biz.UserV1().Create(ctx, v1rq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.CreateUserResponse{UserId: resp.UserID}, nil
+}
+
+func (h *Handler) UpdateUser(ctx context.Context, rq *pb.UpdateUserRequest) (*pb.UpdateUserResponse, error) {
+	slog.InfoContext(ctx, "Update user function called")
+
+	// version 与 REST API 的 If-Match 请求头相同，只有版本号一致才允许更新
+	v1rq := &apiv1.UpdateUserRequest{
+		UserID:   rq.GetUserId(),
+		Username: rq.Username,
+		Nickname: rq.Nickname,
+		Email:    rq.Email,
+		Phone:    rq.Phone,
+		Version:  rq.Version,
+	}
+	if err := h.val.ValidateUpdateUserRequest(ctx, v1rq); err != nil {
+		return nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error())
+	}
+
+	resp, err := h.biz.UserV1().Update(ctx, v1rq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.UpdateUserResponse{Version: resp.Version}, nil
+}
+
+func (h *Handler) DeleteUser(ctx context.Context, rq *pb.DeleteUserRequest) (*pb.DeleteUserResponse, error) {
+	slog.InfoContext(ctx, "Delete user function called")
+
+	v1rq := &apiv1.DeleteUserRequest{UserID: rq.GetUserId()}
+	if err := h.val.ValidateDeleteUserRequest(ctx, v1rq); err != nil {
+		return nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error())
+	}
+
+	resp, err := h.biz.UserV1().Delete(ctx, v1rq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.DeleteUserResponse{DeletionScheduledAt: timeToTimestamp(&resp.DeletionScheduledAt)}, nil
+}
+
+func (h *Handler) GetUser(ctx context.Context, rq *pb.GetUserRequest) (*pb.GetUserResponse, error) {
+	slog.InfoContext(ctx, "Get user function called")
+
+	v1rq := &apiv1.GetUserRequest{UserID: rq.GetUserId()}
+	if err := h.val.ValidateGetUserRequest(ctx, v1rq); err != nil {
+		return nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error())
+	}
+
+	resp, err := h.biz.UserV1().Get(ctx, v1rq)
+	if err != nil {
+		return nil, err
+	}
+
+	return &pb.GetUserResponse{User: userV1ToPB(resp.User)}, nil
+}
+
+func (h *Handler) ListUser(ctx context.Context, rq *pb.ListUserRequest) (*pb.ListUserResponse, error) {
+	slog.InfoContext(ctx, "List user function called")
+
+	v1rq := &apiv1.ListUserRequest{
+		PageRequest: apiv1.PageRequest{
+			PageToken: rq.GetPageToken(),
+			Limit:     rq.GetLimit(),
+			SortBy:    rq.GetSortBy(),
+			Order:     rq.GetOrder(),
+			WithTotal: rq.GetWithTotal(),
+		},
+		QueryRequest: apiv1.QueryRequest{Filter: rq.GetFilter(), OrderBy: rq.GetOrderBy()},
+	}
+	if err := h.val.ValidateListUserRequest(ctx, v1rq); err != nil {
+		return nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error())
+	}
+
+	resp, err := h.biz.UserV1().List(ctx, v1rq)
+	if err != nil {
+		return nil, err
+	}
+
+	users := make([]*pb.User, 0, len(resp.Users))
+	for _, user := range resp.Users {
+		users = append(users, userV1ToPB(user))
+	}
+	return &pb.ListUserResponse{TotalCount: resp.TotalCount, Users: users, NextPageToken: resp.NextPageToken}, nil
+}
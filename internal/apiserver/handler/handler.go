@@ -1,8 +1,13 @@
 package handler
 
 import (
+	"github.com/gin-gonic/gin"
+
 	"github.com/onexstack/fastgo/internal/apiserver/biz"
 	"github.com/onexstack/fastgo/internal/apiserver/pkg/conversion/validation"
+	"github.com/onexstack/fastgo/internal/pkg/core"
+	"github.com/onexstack/fastgo/internal/pkg/errorsx"
+	"github.com/onexstack/fastgo/internal/pkg/query"
 )
 
 type Handler struct {
@@ -16,3 +21,14 @@ func NewHandler(biz biz.IBiz, val *validation.Validator) *Handler {
 		val: val,
 	}
 }
+
+// writeListResponse 返回列表接口的响应，fields 不为空时列表中的每个对象只保留指定的字段.
+func writeListResponse(c *gin.Context, resp any, fields string) {
+	projected, err := query.Project(resp, query.SplitFields(fields))
+	if err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrInternal)
+		return
+	}
+
+	core.WriteResponse(c, projected, nil)
+}
@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"log/slog"
+
+	"github.com/gin-gonic/gin"
+	"github.com/onexstack/fastgo/internal/pkg/core"
+	v1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+	"github.com/onexstack/onexstack/pkg/errorsx"
+)
+
+func (h *Handler) CreatePolicy(c *gin.Context) {
+	slog.InfoContext(c.Request.Context(), "Create policy function called")
+
+	var rq v1.CreatePolicyRequest
+	if err := c.ShouldBindJSON(&rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrBind)
+		return
+	}
+
+	if err := h.val.ValidateCreatePolicyRequest(c, &rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error()))
+		return
+	}
+
+	resp, err := h.biz.PolicyV1().Create(c.Request.Context(), &rq)
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	core.WriteResponse(c, resp, nil)
+}
+
+func (h *Handler) DeletePolicy(c *gin.Context) {
+	slog.InfoContext(c.Request.Context(), "Delete policy function called")
+
+	var rq v1.DeletePolicyRequest
+	if err := c.ShouldBindJSON(&rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrBind)
+		return
+	}
+
+	if err := h.val.ValidateDeletePolicyRequest(c, &rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error()))
+		return
+	}
+
+	resp, err := h.biz.PolicyV1().Delete(c.Request.Context(), &rq)
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	core.WriteResponse(c, resp, nil)
+}
+
+func (h *Handler) ListPolicy(c *gin.Context) {
+	slog.InfoContext(c.Request.Context(), "List policy function called")
+
+	var rq v1.ListPolicyRequest
+	if err := c.ShouldBindQuery(&rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrBind)
+		return
+	}
+
+	if err := h.val.ValidateListPolicyRequest(c, &rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error()))
+		return
+	}
+
+	resp, err := h.biz.PolicyV1().List(c.Request.Context(), &rq)
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	writeListResponse(c, resp, rq.Fields)
+}
@@ -10,7 +10,7 @@ import (
 )
 
 func (h *Handler) CreatePost(c *gin.Context) {
-	slog.Info("Create post function called")
+	slog.InfoContext(c.Request.Context(), "Create post function called")
 
 	var rq v1.CreatePostRequest
 	if err := c.ShouldBindJSON(&rq); err != nil {
@@ -34,14 +34,27 @@ func (h *Handler) CreatePost(c *gin.Context) {
 }
 
 func (h *Handler) UpdatePost(c *gin.Context) {
-	slog.Info("Update post function called")
+	slog.InfoContext(c.Request.Context(), "Update post function called")
 
 	var rq v1.UpdatePostRequest
+	if err := c.ShouldBindUri(&rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrBind)
+		return
+	}
+
 	if err := c.ShouldBindJSON(&rq); err != nil {
 		core.WriteResponse(c, nil, errorsx.ErrBind)
 		return
 	}
 
+	// 带有 If-Match 请求头时，只有版本号一致才允许更新
+	version, err := core.IfMatchVersion(c)
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+	rq.Version = version
+
 	if err := h.val.ValidateUpdatePostRequest(c, &rq); err != nil {
 		core.WriteResponse(c, nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error()))
 		return
@@ -53,11 +66,12 @@ func (h *Handler) UpdatePost(c *gin.Context) {
 		return
 	}
 
+	c.Header("ETag", core.ETag(resp.Version))
 	core.WriteResponse(c, resp, nil)
 }
 
 func (h *Handler) DeletePost(c *gin.Context) {
-	slog.Info("Delete post function called")
+	slog.InfoContext(c.Request.Context(), "Delete post function called")
 
 	var rq v1.DeletePostRequest
 	if err := c.ShouldBindJSON(&rq); err != nil {
@@ -75,10 +89,10 @@ func (h *Handler) DeletePost(c *gin.Context) {
 }
 
 func (h *Handler) GetPost(c *gin.Context) {
-	slog.Info("Get post function called")
+	slog.InfoContext(c.Request.Context(), "Get post function called")
 
 	var rq v1.GetPostRequest
-	if err := c.ShouldBindQuery(&rq); err != nil {
+	if err := c.ShouldBindUri(&rq); err != nil {
 		core.WriteResponse(c, nil, errorsx.ErrBind)
 		return
 	}
@@ -94,11 +108,11 @@ func (h *Handler) GetPost(c *gin.Context) {
 		return
 	}
 
-	core.WriteResponse(c, resp, nil)
+	core.WriteResponseWithETag(c, resp, resp.Post.Version)
 }
 
 func (h *Handler) ListPost(c *gin.Context) {
-	slog.Info("List post function called")
+	slog.InfoContext(c.Request.Context(), "List post function called")
 
 	var rq v1.ListPostRequest
 	if err := c.ShouldBindQuery(&rq); err != nil {
@@ -117,5 +131,188 @@ func (h *Handler) ListPost(c *gin.Context) {
 		return
 	}
 
+	writeListResponse(c, resp, rq.Fields)
+}
+
+func (h *Handler) SearchPost(c *gin.Context) {
+	slog.InfoContext(c.Request.Context(), "Search post function called")
+
+	var rq v1.SearchPostRequest
+	if err := c.ShouldBindQuery(&rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrBind)
+		return
+	}
+
+	if err := h.val.ValidateSearchPostRequest(c, &rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error()))
+		return
+	}
+
+	resp, err := h.biz.PostV1().Search(c.Request.Context(), &rq)
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	core.WriteResponse(c, resp, nil)
+}
+
+func (h *Handler) PublishPost(c *gin.Context) {
+	slog.InfoContext(c.Request.Context(), "Publish post function called")
+
+	var rq v1.PublishPostRequest
+	if err := c.ShouldBindUri(&rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrBind)
+		return
+	}
+
+	// 请求体是可选的，不指定 publishAt 时立即发布
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&rq); err != nil {
+			core.WriteResponse(c, nil, errorsx.ErrBind)
+			return
+		}
+	}
+
+	if err := h.val.ValidatePublishPostRequest(c, &rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error()))
+		return
+	}
+
+	resp, err := h.biz.PostV1().Publish(c.Request.Context(), &rq)
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	core.WriteResponse(c, resp, nil)
+}
+
+func (h *Handler) UnpublishPost(c *gin.Context) {
+	slog.InfoContext(c.Request.Context(), "Unpublish post function called")
+
+	var rq v1.UnpublishPostRequest
+	if err := c.ShouldBindUri(&rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrBind)
+		return
+	}
+
+	// 请求体是可选的，默认将文章改回草稿
+	if c.Request.ContentLength > 0 {
+		if err := c.ShouldBindJSON(&rq); err != nil {
+			core.WriteResponse(c, nil, errorsx.ErrBind)
+			return
+		}
+	}
+
+	if err := h.val.ValidateUnpublishPostRequest(c, &rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error()))
+		return
+	}
+
+	resp, err := h.biz.PostV1().Unpublish(c.Request.Context(), &rq)
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	core.WriteResponse(c, resp, nil)
+}
+
+func (h *Handler) ListPublicPost(c *gin.Context) {
+	slog.InfoContext(c.Request.Context(), "List public post function called")
+
+	var rq v1.ListPublicPostRequest
+	if err := c.ShouldBindQuery(&rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrBind)
+		return
+	}
+
+	// /v1/public/users/:username/posts 路由通过路径参数指定作者
+	if err := c.ShouldBindUri(&rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrBind)
+		return
+	}
+
+	if err := h.val.ValidateListPublicPostRequest(c, &rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error()))
+		return
+	}
+
+	resp, err := h.biz.PostV1().ListPublic(c.Request.Context(), &rq)
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	writeListResponse(c, resp, rq.Fields)
+}
+
+func (h *Handler) GetPublicPost(c *gin.Context) {
+	slog.InfoContext(c.Request.Context(), "Get public post function called")
+
+	var rq v1.GetPublicPostRequest
+	if err := c.ShouldBindUri(&rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrBind)
+		return
+	}
+
+	if err := h.val.ValidateGetPublicPostRequest(c, &rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error()))
+		return
+	}
+
+	resp, err := h.biz.PostV1().GetPublic(c.Request.Context(), &rq)
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	core.WriteResponse(c, resp, nil)
+}
+
+func (h *Handler) ListTrashPost(c *gin.Context) {
+	slog.InfoContext(c.Request.Context(), "List trash post function called")
+
+	var rq v1.ListTrashPostRequest
+	if err := c.ShouldBindQuery(&rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrBind)
+		return
+	}
+
+	if err := h.val.ValidateListTrashPostRequest(c, &rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error()))
+		return
+	}
+
+	resp, err := h.biz.PostV1().ListTrash(c.Request.Context(), &rq)
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	writeListResponse(c, resp, rq.Fields)
+}
+
+func (h *Handler) RestorePost(c *gin.Context) {
+	slog.InfoContext(c.Request.Context(), "Restore post function called")
+
+	var rq v1.RestorePostRequest
+	if err := c.ShouldBindUri(&rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrBind)
+		return
+	}
+
+	if err := h.val.ValidateRestorePostRequest(c, &rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error()))
+		return
+	}
+
+	resp, err := h.biz.PostV1().Restore(c.Request.Context(), &rq)
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
 	core.WriteResponse(c, resp, nil)
 }
@@ -10,7 +10,7 @@ import (
 )
 
 func (h *Handler) Login(c *gin.Context) {
-	slog.Info("Login function called")
+	slog.InfoContext(c.Request.Context(), "Login function called")
 
 	var rq v1.LoginRequest
 	if err := c.ShouldBindJSON(&rq); err != nil {
@@ -33,7 +33,7 @@ func (h *Handler) Login(c *gin.Context) {
 }
 
 func (h *Handler) RefreshToken(c *gin.Context) {
-	slog.Info("Refresh token function called")
+	slog.InfoContext(c.Request.Context(), "Refresh token function called")
 
 	var rq v1.RefreshTokenRequest
 	if err := c.ShouldBindJSON(&rq); err != nil {
@@ -55,10 +55,39 @@ func (h *Handler) RefreshToken(c *gin.Context) {
 	core.WriteResponse(c, resp, nil)
 }
 
+func (h *Handler) Logout(c *gin.Context) {
+	slog.InfoContext(c.Request.Context(), "Logout function called")
+
+	resp, err := h.biz.UserV1().Logout(c.Request.Context(), &v1.LogoutRequest{})
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	core.WriteResponse(c, resp, nil)
+}
+
+func (h *Handler) LogoutAll(c *gin.Context) {
+	slog.InfoContext(c.Request.Context(), "Logout all function called")
+
+	resp, err := h.biz.UserV1().LogoutAll(c.Request.Context(), &v1.LogoutAllRequest{})
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	core.WriteResponse(c, resp, nil)
+}
+
 func (h *Handler) ChangePassword(c *gin.Context) {
-	slog.Info("Change password function called")
+	slog.InfoContext(c.Request.Context(), "Change password function called")
 
 	var rq v1.ChangePasswordRequest
+	if err := c.ShouldBindUri(&rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrBind)
+		return
+	}
+
 	if err := c.ShouldBindJSON(&rq); err != nil {
 		core.WriteResponse(c, nil, errorsx.ErrBind)
 		return
@@ -79,7 +108,7 @@ func (h *Handler) ChangePassword(c *gin.Context) {
 }
 
 func (h *Handler) CreateUser(c *gin.Context) {
-	slog.Info("Create user function called")
+	slog.InfoContext(c.Request.Context(), "Create user function called")
 
 	var rq v1.CreateUserRequest
 	if err := c.ShouldBindJSON(&rq); err != nil {
@@ -102,14 +131,27 @@ func (h *Handler) CreateUser(c *gin.Context) {
 }
 
 func (h *Handler) UpdateUser(c *gin.Context) {
-	slog.Info("Update user function called")
+	slog.InfoContext(c.Request.Context(), "Update user function called")
 
 	var rq v1.UpdateUserRequest
+	if err := c.ShouldBindUri(&rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrBind)
+		return
+	}
+
 	if err := c.ShouldBindJSON(&rq); err != nil {
 		core.WriteResponse(c, nil, errorsx.ErrBind)
 		return
 	}
 
+	// 带有 If-Match 请求头时，只有版本号一致才允许更新
+	version, err := core.IfMatchVersion(c)
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+	rq.Version = version
+
 	if err := h.val.ValidateUpdateUserRequest(c, &rq); err != nil {
 		core.WriteResponse(c, nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error()))
 		return
@@ -121,14 +163,15 @@ func (h *Handler) UpdateUser(c *gin.Context) {
 		return
 	}
 
+	c.Header("ETag", core.ETag(resp.Version))
 	core.WriteResponse(c, resp, nil)
 }
 
 func (h *Handler) DeleteUser(c *gin.Context) {
-	slog.Info("Delete user function called")
+	slog.InfoContext(c.Request.Context(), "Delete user function called")
 
 	var rq v1.DeleteUserRequest
-	if err := c.ShouldBindJSON(&rq); err != nil {
+	if err := c.ShouldBindUri(&rq); err != nil {
 		core.WriteResponse(c, nil, errorsx.ErrBind)
 		return
 	}
@@ -148,10 +191,10 @@ func (h *Handler) DeleteUser(c *gin.Context) {
 }
 
 func (h *Handler) GetUser(c *gin.Context) {
-	slog.Info("Get user function called")
+	slog.InfoContext(c.Request.Context(), "Get user function called")
 
 	var rq v1.GetUserRequest
-	if err := c.ShouldBindQuery(&rq); err != nil {
+	if err := c.ShouldBindUri(&rq); err != nil {
 		core.WriteResponse(c, nil, errorsx.ErrBind)
 		return
 	}
@@ -167,11 +210,11 @@ func (h *Handler) GetUser(c *gin.Context) {
 		return
 	}
 
-	core.WriteResponse(c, resp, nil)
+	core.WriteResponseWithETag(c, resp, resp.User.Version)
 }
 
 func (h *Handler) ListUser(c *gin.Context) {
-	slog.Info("List user function called")
+	slog.InfoContext(c.Request.Context(), "List user function called")
 
 	var rq v1.ListUserRequest
 	if err := c.ShouldBindQuery(&rq); err != nil {
@@ -190,5 +233,102 @@ func (h *Handler) ListUser(c *gin.Context) {
 		return
 	}
 
+	writeListResponse(c, resp, rq.Fields)
+}
+
+func (h *Handler) UpdateUserRole(c *gin.Context) {
+	slog.InfoContext(c.Request.Context(), "Update user role function called")
+
+	var rq v1.UpdateUserRoleRequest
+	if err := c.ShouldBindUri(&rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrBind)
+		return
+	}
+
+	if err := c.ShouldBindJSON(&rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrBind)
+		return
+	}
+
+	if err := h.val.ValidateUpdateUserRoleRequest(c, &rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error()))
+		return
+	}
+
+	resp, err := h.biz.UserV1().UpdateRole(c.Request.Context(), &rq)
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	core.WriteResponse(c, resp, nil)
+}
+
+func (h *Handler) ListTrashUser(c *gin.Context) {
+	slog.InfoContext(c.Request.Context(), "List trash user function called")
+
+	var rq v1.ListTrashUserRequest
+	if err := c.ShouldBindQuery(&rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrBind)
+		return
+	}
+
+	if err := h.val.ValidateListTrashUserRequest(c, &rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error()))
+		return
+	}
+
+	resp, err := h.biz.UserV1().ListTrash(c.Request.Context(), &rq)
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	writeListResponse(c, resp, rq.Fields)
+}
+
+func (h *Handler) RestoreUser(c *gin.Context) {
+	slog.InfoContext(c.Request.Context(), "Restore user function called")
+
+	var rq v1.RestoreUserRequest
+	if err := c.ShouldBindUri(&rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrBind)
+		return
+	}
+
+	if err := h.val.ValidateRestoreUserRequest(c, &rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error()))
+		return
+	}
+
+	resp, err := h.biz.UserV1().Restore(c.Request.Context(), &rq)
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	core.WriteResponse(c, resp, nil)
+}
+
+func (h *Handler) CancelUserDeletion(c *gin.Context) {
+	slog.InfoContext(c.Request.Context(), "Cancel user deletion function called")
+
+	var rq v1.CancelUserDeletionRequest
+	if err := c.ShouldBindUri(&rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrBind)
+		return
+	}
+
+	if err := h.val.ValidateCancelUserDeletionRequest(c, &rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error()))
+		return
+	}
+
+	resp, err := h.biz.UserV1().CancelDeletion(c.Request.Context(), &rq)
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
 	core.WriteResponse(c, resp, nil)
 }
@@ -0,0 +1,93 @@
+package handler
+
+import (
+	"archive/zip"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/onexstack/onexstack/pkg/errorsx"
+
+	"github.com/onexstack/fastgo/internal/pkg/core"
+	"github.com/onexstack/fastgo/internal/pkg/known"
+	v1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+)
+
+func (h *Handler) ExportUser(c *gin.Context) {
+	slog.InfoContext(c.Request.Context(), "Export user function called")
+
+	var rq v1.ExportUserRequest
+	if err := c.ShouldBindUri(&rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrBind)
+		return
+	}
+
+	if err := c.ShouldBindQuery(&rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrBind)
+		return
+	}
+
+	if err := h.val.ValidateExportUserRequest(c, &rq); err != nil {
+		core.WriteResponse(c, nil, errorsx.ErrInvalidArgument.WithMessage("%s", err.Error()))
+		return
+	}
+
+	resp, err := h.biz.UserV1().Export(c.Request.Context(), &rq)
+	if err != nil {
+		core.WriteResponse(c, nil, err)
+		return
+	}
+
+	if rq.Format != known.ExportFormatZIP {
+		filename := fmt.Sprintf("%s-export.json", resp.User.Username)
+		c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+		core.WriteResponse(c, resp, nil)
+		return
+	}
+
+	data, err := exportArchive(resp)
+	if err != nil {
+		slog.ErrorContext(c.Request.Context(), "Failed to create export archive", "err", err)
+		core.WriteResponse(c, nil, errorsx.ErrInternal)
+		return
+	}
+
+	filename := fmt.Sprintf("%s-export.zip", resp.User.Username)
+	c.Header("Content-Disposition", fmt.Sprintf("attachment; filename=%q", filename))
+	c.Data(http.StatusOK, "application/zip", data)
+}
+
+// exportArchive 将导出数据打包为 ZIP 文件，包含完整的 export.json，以及每篇博客一个 Markdown 文件，方便直接阅读.
+func exportArchive(resp *v1.ExportUserResponse) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := zip.NewWriter(&buf)
+
+	w, err := zw.Create("export.json")
+	if err != nil {
+		return nil, err
+	}
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	if err := enc.Encode(resp); err != nil {
+		return nil, err
+	}
+
+	for _, post := range resp.Posts {
+		w, err := zw.Create(fmt.Sprintf("posts/%s.md", post.PostID))
+		if err != nil {
+			return nil, err
+		}
+		if _, err := fmt.Fprintf(w, "# %s\n\n%s\n", post.Title, post.Content); err != nil {
+			return nil, err
+		}
+	}
+
+	if err := zw.Close(); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
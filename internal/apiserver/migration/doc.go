@@ -0,0 +1,11 @@
+/*
+Package migration 提供了 fastgo 数据库表结构的版本化迁移功能.
+
+迁移文件内嵌在二进制中，按数据库方言（mysql、sqlite）分目录存放，文件名格式为：
+
+	{version}_{name}.up.sql
+	{version}_{name}.down.sql
+
+已执行的迁移版本记录在 schema_migrations 表中。可以通过 fg-apiserver migrate 子命令执行、回滚、查看和创建迁移.
+*/
+package migration // import "github.com/onexstack/fastgo/internal/apiserver/migration"
@@ -0,0 +1,319 @@
+package migration
+
+import (
+	"context"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path"
+	"path/filepath"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// DefaultDir 是 migrate create 命令默认写入迁移文件的目录（相对于项目根目录）.
+const DefaultDir = "internal/apiserver/migration/sql"
+
+// 每个数据库方言都维护一套迁移文件，文件名格式为 {version}_{name}.{up|down}.sql.
+//
+//go:embed sql
+var migrationFS embed.FS
+
+var (
+	// filenameRegexp 用于解析迁移文件名.
+	filenameRegexp = regexp.MustCompile(`^(\d+)_([a-z0-9_]+)\.(up|down)\.sql$`)
+	// nameRegexp 用于校验新建迁移的名称.
+	nameRegexp = regexp.MustCompile(`^[a-z0-9_]+$`)
+)
+
+// dialects 定义了需要维护迁移文件的数据库方言.
+var dialects = []string{"mysql", "sqlite"}
+
+// Migration 表示一个版本的数据库迁移.
+type Migration struct {
+	Version int64
+	Name    string
+	Up      string
+	Down    string
+}
+
+// Status 表示一个迁移版本的执行状态.
+type Status struct {
+	Migration
+	// Applied 表示该迁移是否已执行.
+	Applied bool
+	// AppliedAt 表示该迁移的执行时间，未执行时为零值.
+	AppliedAt time.Time
+}
+
+// schemaMigration 是记录已执行迁移版本的簿记表.
+type schemaMigration struct {
+	Version   int64     `gorm:"column:version;primaryKey"`
+	Name      string    `gorm:"column:name"`
+	AppliedAt time.Time `gorm:"column:appliedAt"`
+}
+
+// TableName 返回簿记表的表名.
+func (*schemaMigration) TableName() string {
+	return "schema_migrations"
+}
+
+// Migrator 负责按版本顺序执行和回滚数据库迁移.
+type Migrator struct {
+	db         *gorm.DB
+	migrations []Migration
+}
+
+// New 根据 db 的数据库方言加载内嵌的迁移文件，并创建 Migrator 实例.
+func New(db *gorm.DB) (*Migrator, error) {
+	migrations, err := load(migrationFS, db.Dialector.Name())
+	if err != nil {
+		return nil, err
+	}
+
+	return &Migrator{db: db, migrations: migrations}, nil
+}
+
+// load 读取 fsys 中指定方言下的所有迁移文件，并按版本号升序排列.
+// 文件名不符合迁移文件格式的 .sql 文件会返回错误，避免迁移因为文件名写错而被静默跳过.
+func load(fsys fs.FS, dialect string) ([]Migration, error) {
+	dir := path.Join("sql", dialect)
+	entries, err := fs.ReadDir(fsys, dir)
+	if err != nil {
+		return nil, fmt.Errorf("unsupported migration dialect '%s': %w", dialect, err)
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, entry := range entries {
+		if entry.IsDir() || path.Ext(entry.Name()) != ".sql" {
+			continue
+		}
+
+		matches := filenameRegexp.FindStringSubmatch(entry.Name())
+		if matches == nil {
+			return nil, fmt.Errorf("malformed migration file name '%s', must be {version}_{name}.{up|down}.sql", entry.Name())
+		}
+
+		version, _ := strconv.ParseInt(matches[1], 10, 64)
+		content, err := fs.ReadFile(fsys, path.Join(dir, entry.Name()))
+		if err != nil {
+			return nil, err
+		}
+
+		m, ok := byVersion[version]
+		if !ok {
+			m = &Migration{Version: version, Name: matches[2]}
+			byVersion[version] = m
+		}
+		if m.Name != matches[2] {
+			return nil, fmt.Errorf("migration version %d has conflicting names '%s' and '%s'", version, m.Name, matches[2])
+		}
+
+		if matches[3] == "up" {
+			m.Up = string(content)
+		} else {
+			m.Down = string(content)
+		}
+	}
+
+	migrations := make([]Migration, 0, len(byVersion))
+	for _, m := range byVersion {
+		if m.Up == "" {
+			return nil, fmt.Errorf("migration %d_%s is missing the up file", m.Version, m.Name)
+		}
+		migrations = append(migrations, *m)
+	}
+
+	sort.Slice(migrations, func(i, j int) bool { return migrations[i].Version < migrations[j].Version })
+
+	return migrations, nil
+}
+
+// Up 按版本顺序执行所有未执行的迁移，并返回本次执行的迁移列表.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for i, mig := range pending {
+		err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := execScript(tx, mig.Up); err != nil {
+				return err
+			}
+
+			return tx.Create(&schemaMigration{Version: mig.Version, Name: mig.Name, AppliedAt: time.Now()}).Error
+		})
+		if err != nil {
+			return pending[:i], fmt.Errorf("failed to apply migration %d_%s: %w", mig.Version, mig.Name, err)
+		}
+	}
+
+	return pending, nil
+}
+
+// Down 按版本倒序回滚最近执行的 n 个迁移，并返回本次回滚的迁移列表.
+func (m *Migrator) Down(ctx context.Context, n int) ([]Migration, error) {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var rollback []Migration
+	for i := len(statuses) - 1; i >= 0 && len(rollback) < n; i-- {
+		if statuses[i].Applied {
+			rollback = append(rollback, statuses[i].Migration)
+		}
+	}
+
+	for i, mig := range rollback {
+		if mig.Down == "" {
+			return rollback[:i], fmt.Errorf("migration %d_%s has no down file", mig.Version, mig.Name)
+		}
+
+		err := m.db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
+			if err := execScript(tx, mig.Down); err != nil {
+				return err
+			}
+
+			return tx.Delete(&schemaMigration{}, "version = ?", mig.Version).Error
+		})
+		if err != nil {
+			return rollback[:i], fmt.Errorf("failed to roll back migration %d_%s: %w", mig.Version, mig.Name, err)
+		}
+	}
+
+	return rollback, nil
+}
+
+// Status 返回所有已知迁移的执行状态，按版本号升序排列.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	applied, err := m.applied(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	statuses := make([]Status, 0, len(m.migrations))
+	for _, mig := range m.migrations {
+		st := Status{Migration: mig}
+		if record, ok := applied[mig.Version]; ok {
+			st.Applied = true
+			st.AppliedAt = record.AppliedAt
+		}
+		statuses = append(statuses, st)
+	}
+
+	return statuses, nil
+}
+
+// Pending 返回所有未执行的迁移，按版本号升序排列.
+func (m *Migrator) Pending(ctx context.Context) ([]Migration, error) {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var pending []Migration
+	for _, st := range statuses {
+		if !st.Applied {
+			pending = append(pending, st.Migration)
+		}
+	}
+
+	return pending, nil
+}
+
+// applied 确保簿记表存在，并返回已执行的迁移记录.
+func (m *Migrator) applied(ctx context.Context) (map[int64]schemaMigration, error) {
+	db := m.db.WithContext(ctx)
+	if err := db.Exec("CREATE TABLE IF NOT EXISTS `schema_migrations` (" +
+		"`version` BIGINT NOT NULL PRIMARY KEY," +
+		"`name` VARCHAR(255) NOT NULL," +
+		"`appliedAt` DATETIME NOT NULL)").Error; err != nil {
+		return nil, err
+	}
+
+	var records []schemaMigration
+	if err := db.Find(&records).Error; err != nil {
+		return nil, err
+	}
+
+	applied := make(map[int64]schemaMigration, len(records))
+	for _, record := range records {
+		applied[record.Version] = record
+	}
+
+	return applied, nil
+}
+
+// execScript 逐条执行迁移脚本中以分号结尾的 SQL 语句.
+// MySQL 驱动默认不允许一次执行多条语句，所以需要先拆分.
+func execScript(tx *gorm.DB, script string) error {
+	var lines []string
+	for _, line := range strings.Split(script, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "--") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+
+	for _, stmt := range strings.Split(strings.Join(lines, "\n"), ";") {
+		if strings.TrimSpace(stmt) == "" {
+			continue
+		}
+		if err := tx.Exec(stmt).Error; err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// Create 在 dir 下为每种数据库方言创建一组新的空白迁移文件，并返回创建的文件路径.
+// 新版本号为 dir 中已有的最大版本号加 1.
+func Create(dir string, name string) ([]string, error) {
+	name = strings.ToLower(strings.TrimSpace(name))
+	if !nameRegexp.MatchString(name) {
+		return nil, errors.New("migration name must consist of lowercase letters, digits and underscores only")
+	}
+
+	var latest int64
+	for _, dialect := range dialects {
+		entries, err := os.ReadDir(filepath.Join(dir, dialect))
+		if err != nil && !errors.Is(err, fs.ErrNotExist) {
+			return nil, err
+		}
+
+		for _, entry := range entries {
+			if matches := filenameRegexp.FindStringSubmatch(entry.Name()); matches != nil {
+				version, _ := strconv.ParseInt(matches[1], 10, 64)
+				latest = max(latest, version)
+			}
+		}
+	}
+
+	var files []string
+	for _, dialect := range dialects {
+		if err := os.MkdirAll(filepath.Join(dir, dialect), 0o755); err != nil {
+			return files, err
+		}
+
+		for _, direction := range []string{"up", "down"} {
+			file := filepath.Join(dir, dialect, fmt.Sprintf("%06d_%s.%s.sql", latest+1, name, direction))
+			content := fmt.Sprintf("-- %s migration for %06d_%s (%s)\n", direction, latest+1, name, dialect)
+			if err := os.WriteFile(file, []byte(content), 0o644); err != nil {
+				return files, err
+			}
+			files = append(files, file)
+		}
+	}
+
+	return files, nil
+}
@@ -0,0 +1,289 @@
+package migration
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"gorm.io/gorm"
+
+	"github.com/onexstack/fastgo/internal/pkg/testdb"
+)
+
+// newTestMigrator 创建一个使用 SQLite 内存数据库的 Migrator 实例.
+func newTestMigrator(t *testing.T) (*Migrator, *gorm.DB) {
+	t.Helper()
+
+	db := testdb.New(t)
+
+	m, err := New(db)
+	if err != nil {
+		t.Fatalf("failed to create migrator: %v", err)
+	}
+
+	return m, db
+}
+
+func versions(migrations []Migration) []int64 {
+	vs := make([]int64, 0, len(migrations))
+	for _, m := range migrations {
+		vs = append(vs, m.Version)
+	}
+	return vs
+}
+
+func appliedVersions(t *testing.T, db *gorm.DB) []int64 {
+	t.Helper()
+
+	var vs []int64
+	if err := db.Model(&schemaMigration{}).Order("version").Pluck("version", &vs).Error; err != nil {
+		t.Fatalf("failed to query schema_migrations: %v", err)
+	}
+	return vs
+}
+
+func TestLoadEmbedded(t *testing.T) {
+	mysql, err := load(migrationFS, "mysql")
+	if err != nil {
+		t.Fatalf("load mysql: %v", err)
+	}
+	sqlite, err := load(migrationFS, "sqlite")
+	if err != nil {
+		t.Fatalf("load sqlite: %v", err)
+	}
+
+	// 每种方言必须维护相同的迁移版本
+	if !slices.Equal(versions(mysql), versions(sqlite)) {
+		t.Fatalf("mysql versions %v differ from sqlite versions %v", versions(mysql), versions(sqlite))
+	}
+
+	for i, m := range sqlite {
+		if i > 0 && m.Version <= sqlite[i-1].Version {
+			t.Errorf("migrations are not sorted: %d after %d", m.Version, sqlite[i-1].Version)
+		}
+		if m.Down == "" {
+			t.Errorf("migration %d_%s has no down file", m.Version, m.Name)
+		}
+	}
+
+	if _, err := load(migrationFS, "postgres"); err == nil {
+		t.Error("expected an error for an unsupported dialect")
+	}
+}
+
+func TestLoad(t *testing.T) {
+	tests := []struct {
+		name    string
+		files   fstest.MapFS
+		want    []int64
+		wantErr string
+	}{
+		{
+			name: "sorted by version",
+			files: fstest.MapFS{
+				"sql/sqlite/000002_second.up.sql":  {Data: []byte("SELECT 2;")},
+				"sql/sqlite/000001_first.up.sql":   {Data: []byte("SELECT 1;")},
+				"sql/sqlite/000001_first.down.sql": {Data: []byte("SELECT 1;")},
+				"sql/sqlite/README.md":             {Data: []byte("not a migration")},
+			},
+			want: []int64{1, 2},
+		},
+		{
+			name: "malformed file name",
+			files: fstest.MapFS{
+				"sql/sqlite/000001_first.up.sql": {Data: []byte("SELECT 1;")},
+				"sql/sqlite/2_Second-Table.sql":  {Data: []byte("SELECT 2;")},
+			},
+			wantErr: "malformed migration file name '2_Second-Table.sql'",
+		},
+		{
+			name: "missing direction",
+			files: fstest.MapFS{
+				"sql/sqlite/000001_first.sql": {Data: []byte("SELECT 1;")},
+			},
+			wantErr: "malformed migration file name",
+		},
+		{
+			name: "missing up file",
+			files: fstest.MapFS{
+				"sql/sqlite/000001_first.down.sql": {Data: []byte("SELECT 1;")},
+			},
+			wantErr: "missing the up file",
+		},
+		{
+			name: "conflicting names",
+			files: fstest.MapFS{
+				"sql/sqlite/000001_first.up.sql":   {Data: []byte("SELECT 1;")},
+				"sql/sqlite/000001_other.down.sql": {Data: []byte("SELECT 1;")},
+			},
+			wantErr: "conflicting names",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := load(tt.files, "sqlite")
+			if tt.wantErr != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+					t.Fatalf("load() error = %v, want error containing %q", err, tt.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("load() error = %v", err)
+			}
+			if !slices.Equal(versions(got), tt.want) {
+				t.Errorf("load() versions = %v, want %v", versions(got), tt.want)
+			}
+		})
+	}
+}
+
+func TestMigratorUpDown(t *testing.T) {
+	ctx := context.Background()
+	m, db := newTestMigrator(t)
+	all := versions(m.migrations)
+
+	pending, err := m.Pending(ctx)
+	if err != nil {
+		t.Fatalf("Pending() error = %v", err)
+	}
+	if !slices.Equal(versions(pending), all) {
+		t.Fatalf("Pending() on an empty database = %v, want %v", versions(pending), all)
+	}
+
+	applied, err := m.Up(ctx)
+	if err != nil {
+		t.Fatalf("Up() error = %v", err)
+	}
+	if !slices.Equal(versions(applied), all) {
+		t.Fatalf("Up() applied %v, want %v", versions(applied), all)
+	}
+	if got := appliedVersions(t, db); !slices.Equal(got, all) {
+		t.Fatalf("schema_migrations = %v, want %v", got, all)
+	}
+	if !db.Migrator().HasTable("user") || !db.Migrator().HasTable("post") {
+		t.Fatal("Up() did not create the user and post tables")
+	}
+
+	// 再次执行 Up 不应重复执行迁移
+	if applied, err := m.Up(ctx); err != nil || len(applied) != 0 {
+		t.Fatalf("second Up() = %v, %v, want no migrations", versions(applied), err)
+	}
+
+	rolledBack, err := m.Down(ctx, 2)
+	if err != nil {
+		t.Fatalf("Down(2) error = %v", err)
+	}
+	want := []int64{all[len(all)-1], all[len(all)-2]}
+	if !slices.Equal(versions(rolledBack), want) {
+		t.Fatalf("Down(2) rolled back %v, want %v", versions(rolledBack), want)
+	}
+	if got := appliedVersions(t, db); !slices.Equal(got, all[:len(all)-2]) {
+		t.Fatalf("schema_migrations after Down(2) = %v, want %v", got, all[:len(all)-2])
+	}
+
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		t.Fatalf("Status() error = %v", err)
+	}
+	for i, st := range statuses {
+		wantApplied := i < len(all)-2
+		if st.Applied != wantApplied {
+			t.Errorf("Status() version %d applied = %v, want %v", st.Version, st.Applied, wantApplied)
+		}
+		if st.Applied == st.AppliedAt.IsZero() {
+			t.Errorf("Status() version %d appliedAt = %v, applied = %v", st.Version, st.AppliedAt, st.Applied)
+		}
+	}
+
+	// 回滚的迁移可以重新执行
+	applied, err = m.Up(ctx)
+	if err != nil {
+		t.Fatalf("Up() after Down(2) error = %v", err)
+	}
+	if !slices.Equal(versions(applied), all[len(all)-2:]) {
+		t.Fatalf("Up() after Down(2) applied %v, want %v", versions(applied), all[len(all)-2:])
+	}
+
+	// 回滚步数超过已执行的迁移数时，只回滚全部已执行的迁移
+	rolledBack, err = m.Down(ctx, len(all)+5)
+	if err != nil {
+		t.Fatalf("Down(all) error = %v", err)
+	}
+	if len(rolledBack) != len(all) {
+		t.Fatalf("Down(all) rolled back %d migrations, want %d", len(rolledBack), len(all))
+	}
+	if got := appliedVersions(t, db); len(got) != 0 {
+		t.Fatalf("schema_migrations after Down(all) = %v, want empty", got)
+	}
+	if db.Migrator().HasTable("user") {
+		t.Fatal("Down(all) did not drop the user table")
+	}
+}
+
+func TestMigratorUpFailure(t *testing.T) {
+	ctx := context.Background()
+	_, db := newTestMigrator(t)
+
+	m := &Migrator{db: db, migrations: []Migration{
+		{Version: 1, Name: "ok", Up: "CREATE TABLE `t1` (`id` INTEGER);", Down: "DROP TABLE `t1`;"},
+		{Version: 2, Name: "broken", Up: "CREATE TABLE `t2` (`id` INTEGER); NOT VALID SQL;", Down: "DROP TABLE `t2`;"},
+	}}
+
+	applied, err := m.Up(ctx)
+	if err == nil || !strings.Contains(err.Error(), "migration 2_broken") {
+		t.Fatalf("Up() error = %v, want a failure for migration 2", err)
+	}
+	if !slices.Equal(versions(applied), []int64{1}) {
+		t.Fatalf("Up() applied %v, want [1]", versions(applied))
+	}
+
+	// 失败的迁移在事务中执行，不应留下部分结果和版本记录
+	if got := appliedVersions(t, db); !slices.Equal(got, []int64{1}) {
+		t.Fatalf("schema_migrations = %v, want [1]", got)
+	}
+	if db.Migrator().HasTable("t2") {
+		t.Fatal("failed migration left table t2 behind")
+	}
+}
+
+func TestCreate(t *testing.T) {
+	dir := t.TempDir()
+	if err := os.MkdirAll(filepath.Join(dir, "sqlite"), 0o755); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "sqlite", "000007_existing.up.sql"), nil, 0o644); err != nil {
+		t.Fatal(err)
+	}
+
+	files, err := Create(dir, " Add_Index ")
+	if err != nil {
+		t.Fatalf("Create() error = %v", err)
+	}
+
+	want := []string{
+		filepath.Join(dir, "mysql", "000008_add_index.up.sql"),
+		filepath.Join(dir, "mysql", "000008_add_index.down.sql"),
+		filepath.Join(dir, "sqlite", "000008_add_index.up.sql"),
+		filepath.Join(dir, "sqlite", "000008_add_index.down.sql"),
+	}
+	if strings.Join(files, ",") != strings.Join(want, ",") {
+		t.Fatalf("Create() files = %v, want %v", files, want)
+	}
+	for _, file := range files {
+		if _, err := os.Stat(file); err != nil {
+			t.Errorf("Create() did not write %s: %v", file, err)
+		}
+	}
+
+	for _, name := range []string{"", "add-index", "add index", "../escape"} {
+		if _, err := Create(dir, name); err == nil {
+			t.Errorf("Create(%q) expected an error", name)
+		}
+	}
+}
@@ -0,0 +1,12 @@
+CREATE TABLE IF NOT EXISTS `user` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '用户唯一 ID',
+  `username` varchar(255) NOT NULL DEFAULT '' COMMENT '用户名（唯一）',
+  `password` varchar(255) NOT NULL DEFAULT '' COMMENT '用户密码（加密后）',
+  `nickname` varchar(30) NOT NULL DEFAULT '' COMMENT '用户昵称',
+  `email` varchar(256) NOT NULL DEFAULT '' COMMENT '用户电子邮箱地址',
+  `phone` varchar(16) NOT NULL DEFAULT '' COMMENT '用户手机号',
+  `createdAt` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '用户创建时间',
+  `updatedAt` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '用户最后修改时间',
+  PRIMARY KEY (`id`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='用户表';
@@ -0,0 +1,4 @@
+ALTER TABLE `post` DROP INDEX `idx_post_userID`;
+ALTER TABLE `post` DROP INDEX `idx_post_postID`;
+ALTER TABLE `user` DROP INDEX `idx_user_userID`;
+ALTER TABLE `user` DROP INDEX `idx_user_username`;
@@ -0,0 +1,4 @@
+ALTER TABLE `user` ADD UNIQUE INDEX `idx_user_username` (`username`);
+ALTER TABLE `user` ADD UNIQUE INDEX `idx_user_userID` (`userID`);
+ALTER TABLE `post` ADD UNIQUE INDEX `idx_post_postID` (`postID`);
+ALTER TABLE `post` ADD INDEX `idx_post_userID` (`userID`);
@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS `policy`;
+ALTER TABLE `user` DROP COLUMN `role`;
@@ -0,0 +1,16 @@
+ALTER TABLE `user` ADD COLUMN `role` varchar(16) NOT NULL DEFAULT 'user' COMMENT '用户角色' AFTER `phone`;
+CREATE TABLE IF NOT EXISTS `policy` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `role` varchar(16) NOT NULL DEFAULT '' COMMENT '角色名称',
+  `path` varchar(255) NOT NULL DEFAULT '' COMMENT '路由路径，支持以 * 结尾的前缀匹配',
+  `method` varchar(16) NOT NULL DEFAULT '' COMMENT 'HTTP 方法，* 表示所有方法',
+  `createdAt` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '策略创建时间',
+  `updatedAt` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '策略最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `idx_policy_role_path_method` (`role`, `path`, `method`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='授权策略表';
+INSERT INTO `policy` (`role`, `path`, `method`) VALUES
+  ('admin', '*', '*'),
+  ('user', '/v1/users/:userID', '*'),
+  ('user', '/v1/users/:userID/change-password', 'PUT'),
+  ('user', '/v1/posts*', '*');
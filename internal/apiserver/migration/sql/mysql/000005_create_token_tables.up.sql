@@ -0,0 +1,22 @@
+CREATE TABLE IF NOT EXISTS `refresh_token` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '用户唯一 ID',
+  `sessionID` varchar(36) NOT NULL DEFAULT '' COMMENT '会话 ID，同一次登录轮换出的刷新令牌属于同一会话',
+  `tokenHash` varchar(64) NOT NULL DEFAULT '' COMMENT '刷新令牌的 SHA-256 哈希值',
+  `expiresAt` datetime NOT NULL COMMENT '刷新令牌过期时间',
+  `revokedAt` datetime DEFAULT NULL COMMENT '刷新令牌吊销时间，为空表示未吊销',
+  `createdAt` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '刷新令牌创建时间',
+  `updatedAt` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '刷新令牌最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `idx_refresh_token_tokenHash` (`tokenHash`),
+  INDEX `idx_refresh_token_sessionID` (`sessionID`),
+  INDEX `idx_refresh_token_userID` (`userID`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='刷新令牌表';
+CREATE TABLE IF NOT EXISTS `revoked_token` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `tokenID` varchar(36) NOT NULL DEFAULT '' COMMENT '被吊销的访问令牌 ID（jti）或会话 ID（sid）',
+  `expiresAt` datetime NOT NULL COMMENT '吊销记录过期时间，过期后对应的访问令牌已自然失效',
+  `createdAt` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '吊销时间',
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `idx_revoked_token_tokenID` (`tokenID`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='访问令牌吊销列表';
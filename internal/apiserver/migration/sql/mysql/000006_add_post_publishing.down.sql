@@ -0,0 +1,6 @@
+DROP INDEX `idx_post_status_publishAt` ON `post`;
+ALTER TABLE `post`
+  DROP COLUMN `status`,
+  DROP COLUMN `visibility`,
+  DROP COLUMN `publishAt`,
+  DROP COLUMN `publishedAt`;
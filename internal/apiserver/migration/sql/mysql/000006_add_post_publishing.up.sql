@@ -0,0 +1,8 @@
+ALTER TABLE `post`
+  ADD COLUMN `status` varchar(16) NOT NULL DEFAULT 'draft' COMMENT '博文状态：draft、scheduled、published、archived' AFTER `content`,
+  ADD COLUMN `visibility` varchar(16) NOT NULL DEFAULT 'private' COMMENT '博文可见性：private、unlisted、public' AFTER `status`,
+  ADD COLUMN `publishAt` datetime NULL DEFAULT NULL COMMENT '定时发布时间' AFTER `visibility`,
+  ADD COLUMN `publishedAt` datetime NULL DEFAULT NULL COMMENT '博文发布时间' AFTER `publishAt`;
+-- 已有博文在创建时即已生效，保持发布状态；可见性保持 private，避免未经作者确认就公开
+UPDATE `post` SET `status` = 'published', `publishedAt` = `createdAt`;
+CREATE INDEX `idx_post_status_publishAt` ON `post` (`status`, `publishAt`);
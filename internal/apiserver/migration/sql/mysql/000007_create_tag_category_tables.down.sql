@@ -0,0 +1,6 @@
+DELETE FROM `policy` WHERE `role` = 'user' AND `path` IN ('/v1/tags', '/v1/categories') AND `method` = 'GET';
+DROP INDEX `idx_post_categoryID` ON `post`;
+ALTER TABLE `post` DROP COLUMN `categoryID`;
+DROP TABLE IF EXISTS `category`;
+DROP TABLE IF EXISTS `post_tag`;
+DROP TABLE IF EXISTS `tag`;
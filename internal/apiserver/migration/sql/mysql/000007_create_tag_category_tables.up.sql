@@ -0,0 +1,34 @@
+CREATE TABLE IF NOT EXISTS `tag` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `name` varchar(64) NOT NULL DEFAULT '' COMMENT '标签名称（唯一）',
+  `createdAt` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '标签创建时间',
+  `updatedAt` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '标签最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `idx_tag_name` (`name`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='标签表';
+CREATE TABLE IF NOT EXISTS `post_tag` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `tagID` bigint NOT NULL DEFAULT 0 COMMENT '标签 ID',
+  `createdAt` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '关联创建时间',
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `idx_post_tag_postID_tagID` (`postID`, `tagID`),
+  INDEX `idx_post_tag_tagID` (`tagID`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='博文标签关联表';
+CREATE TABLE IF NOT EXISTS `category` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `categoryID` varchar(40) NOT NULL DEFAULT '' COMMENT '分类唯一 ID',
+  `parentID` varchar(40) NOT NULL DEFAULT '' COMMENT '父分类 ID，顶级分类为空',
+  `name` varchar(64) NOT NULL DEFAULT '' COMMENT '分类名称',
+  `path` varchar(512) NOT NULL DEFAULT '' COMMENT '从顶级分类到当前分类的 ID 路径，格式为 /id1/id2/',
+  `createdAt` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '分类创建时间',
+  `updatedAt` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '分类最后修改时间',
+  PRIMARY KEY (`id`),
+  INDEX `idx_category_categoryID` (`categoryID`),
+  INDEX `idx_category_path` (`path`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='分类表';
+ALTER TABLE `post` ADD COLUMN `categoryID` varchar(40) NOT NULL DEFAULT '' COMMENT '分类 ID' AFTER `content`;
+CREATE INDEX `idx_post_categoryID` ON `post` (`categoryID`);
+INSERT INTO `policy` (`role`, `path`, `method`) VALUES
+  ('user', '/v1/tags', 'GET'),
+  ('user', '/v1/categories', 'GET');
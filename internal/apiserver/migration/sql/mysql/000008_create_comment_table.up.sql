@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS `comment` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `commentID` varchar(40) NOT NULL DEFAULT '' COMMENT '评论唯一 ID',
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '评论者用户 ID',
+  `parentID` varchar(40) NOT NULL DEFAULT '' COMMENT '回复的评论 ID，顶级评论为空',
+  `content` text NOT NULL COMMENT '评论内容',
+  `deletedAt` datetime NULL DEFAULT NULL COMMENT '评论删除时间，删除后保留占位',
+  `createdAt` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '评论创建时间',
+  `updatedAt` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP ON UPDATE CURRENT_TIMESTAMP COMMENT '评论最后修改时间',
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `idx_comment_commentID` (`commentID`),
+  INDEX `idx_comment_postID` (`postID`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='评论表';
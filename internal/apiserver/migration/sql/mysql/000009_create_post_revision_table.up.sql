@@ -0,0 +1,15 @@
+CREATE TABLE IF NOT EXISTS `post_revision` (
+  `id` bigint NOT NULL AUTO_INCREMENT,
+  `postID` varchar(35) NOT NULL DEFAULT '' COMMENT '博文唯一 ID',
+  `revision` bigint NOT NULL DEFAULT 0 COMMENT '修订版本号，同一篇博文内从 1 开始递增',
+  `userID` varchar(36) NOT NULL DEFAULT '' COMMENT '修改者用户 ID',
+  `title` varchar(256) NOT NULL DEFAULT '' COMMENT '该版本的博文标题',
+  `content` longtext NOT NULL COMMENT '该版本的博文内容',
+  `createdAt` datetime NOT NULL DEFAULT CURRENT_TIMESTAMP COMMENT '版本创建时间',
+  PRIMARY KEY (`id`),
+  UNIQUE INDEX `idx_post_revision_postID_revision` (`postID`, `revision`)
+) ENGINE=InnoDB DEFAULT CHARSET=utf8mb4 COMMENT='博文修订历史表';
+
+-- 已有的博文以当前内容作为第一个版本
+INSERT INTO `post_revision` (`postID`, `revision`, `userID`, `title`, `content`, `createdAt`)
+SELECT `postID`, 1, `userID`, `title`, `content`, `updatedAt` FROM `post`;
@@ -0,0 +1,2 @@
+ALTER TABLE `user` ADD COLUMN `version` bigint NOT NULL DEFAULT 1 COMMENT '乐观锁版本号，每次更新加 1' AFTER `role`;
+ALTER TABLE `post` ADD COLUMN `version` bigint NOT NULL DEFAULT 1 COMMENT '乐观锁版本号，每次更新加 1' AFTER `publishedAt`;
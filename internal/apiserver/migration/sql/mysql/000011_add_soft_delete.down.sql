@@ -0,0 +1,5 @@
+-- 回收站中的记录在回滚后会重新出现，先将其永久删除
+DELETE FROM `post` WHERE `deletedAt` IS NOT NULL;
+DELETE FROM `user` WHERE `deletedAt` IS NOT NULL;
+ALTER TABLE `user` DROP INDEX `idx_user_deletedAt`, DROP COLUMN `deletedAt`;
+ALTER TABLE `post` DROP INDEX `idx_post_deletedAt`, DROP COLUMN `deletedAt`;
@@ -0,0 +1,6 @@
+ALTER TABLE `user`
+  ADD COLUMN `deletedAt` datetime NULL DEFAULT NULL COMMENT '用户删除时间，删除后保留在回收站中直到被清理' AFTER `version`,
+  ADD INDEX `idx_user_deletedAt` (`deletedAt`);
+ALTER TABLE `post`
+  ADD COLUMN `deletedAt` datetime NULL DEFAULT NULL COMMENT '博文删除时间，删除后保留在回收站中直到被清理' AFTER `version`,
+  ADD INDEX `idx_post_deletedAt` (`deletedAt`);
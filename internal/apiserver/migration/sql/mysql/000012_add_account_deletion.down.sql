@@ -0,0 +1,2 @@
+DELETE FROM `policy` WHERE `role` = 'user' AND `path` IN ('/v1/users/:userID/export', '/v1/users/:userID/cancel-deletion');
+ALTER TABLE `user` DROP INDEX `idx_user_deletionScheduledAt`, DROP COLUMN `deletionScheduledAt`;
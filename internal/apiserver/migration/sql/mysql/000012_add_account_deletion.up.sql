@@ -0,0 +1,6 @@
+ALTER TABLE `user`
+  ADD COLUMN `deletionScheduledAt` datetime NULL DEFAULT NULL COMMENT '账号计划删除时间，到期前用户可以取消删除' AFTER `version`,
+  ADD INDEX `idx_user_deletionScheduledAt` (`deletionScheduledAt`);
+INSERT INTO `policy` (`role`, `path`, `method`) VALUES
+  ('user', '/v1/users/:userID/export', 'GET'),
+  ('user', '/v1/users/:userID/cancel-deletion', 'POST');
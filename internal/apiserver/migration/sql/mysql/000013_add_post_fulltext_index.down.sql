@@ -0,0 +1 @@
+ALTER TABLE `post` DROP INDEX `idx_post_title_content`;
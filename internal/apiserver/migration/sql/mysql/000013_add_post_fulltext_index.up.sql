@@ -0,0 +1,2 @@
+-- 全文检索使用的 FULLTEXT 索引，ngram 分词器同时支持中文和英文
+ALTER TABLE `post` ADD FULLTEXT INDEX `idx_post_title_content` (`title`, `content`) WITH PARSER ngram;
@@ -0,0 +1 @@
+-- 已清空的评论者无法恢复，无需回滚
@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS `idx_post_userID`;
+DROP INDEX IF EXISTS `idx_post_postID`;
+DROP INDEX IF EXISTS `idx_user_userID`;
+DROP INDEX IF EXISTS `idx_user_username`;
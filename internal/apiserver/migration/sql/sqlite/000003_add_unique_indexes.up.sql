@@ -0,0 +1,4 @@
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_user_username` ON `user` (`username`);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_user_userID` ON `user` (`userID`);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_post_postID` ON `post` (`postID`);
+CREATE INDEX IF NOT EXISTS `idx_post_userID` ON `post` (`userID`);
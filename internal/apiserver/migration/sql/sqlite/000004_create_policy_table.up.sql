@@ -0,0 +1,15 @@
+ALTER TABLE `user` ADD COLUMN `role` TEXT NOT NULL DEFAULT 'user';
+CREATE TABLE IF NOT EXISTS `policy` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `role` TEXT NOT NULL DEFAULT '',
+  `path` TEXT NOT NULL DEFAULT '',
+  `method` TEXT NOT NULL DEFAULT '',
+  `createdAt` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updatedAt` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_policy_role_path_method` ON `policy` (`role`, `path`, `method`);
+INSERT INTO `policy` (`role`, `path`, `method`) VALUES
+  ('admin', '*', '*'),
+  ('user', '/v1/users/:userID', '*'),
+  ('user', '/v1/users/:userID/change-password', 'PUT'),
+  ('user', '/v1/posts*', '*');
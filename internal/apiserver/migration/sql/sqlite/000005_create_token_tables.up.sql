@@ -0,0 +1,20 @@
+CREATE TABLE IF NOT EXISTS `refresh_token` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `userID` TEXT NOT NULL DEFAULT '',
+  `sessionID` TEXT NOT NULL DEFAULT '',
+  `tokenHash` TEXT NOT NULL DEFAULT '',
+  `expiresAt` DATETIME NOT NULL,
+  `revokedAt` DATETIME DEFAULT NULL,
+  `createdAt` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updatedAt` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_refresh_token_tokenHash` ON `refresh_token` (`tokenHash`);
+CREATE INDEX IF NOT EXISTS `idx_refresh_token_sessionID` ON `refresh_token` (`sessionID`);
+CREATE INDEX IF NOT EXISTS `idx_refresh_token_userID` ON `refresh_token` (`userID`);
+CREATE TABLE IF NOT EXISTS `revoked_token` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `tokenID` TEXT NOT NULL DEFAULT '',
+  `expiresAt` DATETIME NOT NULL,
+  `createdAt` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_revoked_token_tokenID` ON `revoked_token` (`tokenID`);
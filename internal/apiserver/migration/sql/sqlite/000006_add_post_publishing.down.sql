@@ -0,0 +1,5 @@
+DROP INDEX IF EXISTS `idx_post_status_publishAt`;
+ALTER TABLE `post` DROP COLUMN `status`;
+ALTER TABLE `post` DROP COLUMN `visibility`;
+ALTER TABLE `post` DROP COLUMN `publishAt`;
+ALTER TABLE `post` DROP COLUMN `publishedAt`;
@@ -0,0 +1,7 @@
+ALTER TABLE `post` ADD COLUMN `status` TEXT NOT NULL DEFAULT 'draft';
+ALTER TABLE `post` ADD COLUMN `visibility` TEXT NOT NULL DEFAULT 'private';
+ALTER TABLE `post` ADD COLUMN `publishAt` DATETIME NULL DEFAULT NULL;
+ALTER TABLE `post` ADD COLUMN `publishedAt` DATETIME NULL DEFAULT NULL;
+-- 已有博文在创建时即已生效，保持发布状态；可见性保持 private，避免未经作者确认就公开
+UPDATE `post` SET `status` = 'published', `publishedAt` = `createdAt`;
+CREATE INDEX IF NOT EXISTS `idx_post_status_publishAt` ON `post` (`status`, `publishAt`);
@@ -0,0 +1,31 @@
+CREATE TABLE IF NOT EXISTS `tag` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `name` TEXT NOT NULL DEFAULT '',
+  `createdAt` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updatedAt` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_tag_name` ON `tag` (`name`);
+CREATE TABLE IF NOT EXISTS `post_tag` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `postID` TEXT NOT NULL DEFAULT '',
+  `tagID` INTEGER NOT NULL DEFAULT 0,
+  `createdAt` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_post_tag_postID_tagID` ON `post_tag` (`postID`, `tagID`);
+CREATE INDEX IF NOT EXISTS `idx_post_tag_tagID` ON `post_tag` (`tagID`);
+CREATE TABLE IF NOT EXISTS `category` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `categoryID` TEXT NOT NULL DEFAULT '',
+  `parentID` TEXT NOT NULL DEFAULT '',
+  `name` TEXT NOT NULL DEFAULT '',
+  `path` TEXT NOT NULL DEFAULT '',
+  `createdAt` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updatedAt` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS `idx_category_categoryID` ON `category` (`categoryID`);
+CREATE INDEX IF NOT EXISTS `idx_category_path` ON `category` (`path`);
+ALTER TABLE `post` ADD COLUMN `categoryID` TEXT NOT NULL DEFAULT '';
+CREATE INDEX IF NOT EXISTS `idx_post_categoryID` ON `post` (`categoryID`);
+INSERT INTO `policy` (`role`, `path`, `method`) VALUES
+  ('user', '/v1/tags', 'GET'),
+  ('user', '/v1/categories', 'GET');
@@ -0,0 +1,13 @@
+CREATE TABLE IF NOT EXISTS `comment` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `commentID` TEXT NOT NULL DEFAULT '',
+  `postID` TEXT NOT NULL DEFAULT '',
+  `userID` TEXT NOT NULL DEFAULT '',
+  `parentID` TEXT NOT NULL DEFAULT '',
+  `content` TEXT NOT NULL DEFAULT '',
+  `deletedAt` DATETIME NULL DEFAULT NULL,
+  `createdAt` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP,
+  `updatedAt` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE INDEX IF NOT EXISTS `idx_comment_commentID` ON `comment` (`commentID`);
+CREATE INDEX IF NOT EXISTS `idx_comment_postID` ON `comment` (`postID`);
@@ -0,0 +1,14 @@
+CREATE TABLE IF NOT EXISTS `post_revision` (
+  `id` INTEGER PRIMARY KEY AUTOINCREMENT,
+  `postID` TEXT NOT NULL DEFAULT '',
+  `revision` INTEGER NOT NULL DEFAULT 0,
+  `userID` TEXT NOT NULL DEFAULT '',
+  `title` TEXT NOT NULL DEFAULT '',
+  `content` TEXT NOT NULL DEFAULT '',
+  `createdAt` DATETIME NOT NULL DEFAULT CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX IF NOT EXISTS `idx_post_revision_postID_revision` ON `post_revision` (`postID`, `revision`);
+
+-- 已有的博文以当前内容作为第一个版本
+INSERT INTO `post_revision` (`postID`, `revision`, `userID`, `title`, `content`, `createdAt`)
+SELECT `postID`, 1, `userID`, `title`, `content`, `updatedAt` FROM `post`;
@@ -0,0 +1,2 @@
+ALTER TABLE `user` ADD COLUMN `version` INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE `post` ADD COLUMN `version` INTEGER NOT NULL DEFAULT 1;
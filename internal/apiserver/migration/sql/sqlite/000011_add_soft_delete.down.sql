@@ -0,0 +1,7 @@
+-- 回收站中的记录在回滚后会重新出现，先将其永久删除
+DELETE FROM `post` WHERE `deletedAt` IS NOT NULL;
+DELETE FROM `user` WHERE `deletedAt` IS NOT NULL;
+DROP INDEX IF EXISTS `idx_user_deletedAt`;
+DROP INDEX IF EXISTS `idx_post_deletedAt`;
+ALTER TABLE `user` DROP COLUMN `deletedAt`;
+ALTER TABLE `post` DROP COLUMN `deletedAt`;
@@ -0,0 +1,4 @@
+ALTER TABLE `user` ADD COLUMN `deletedAt` DATETIME NULL DEFAULT NULL;
+ALTER TABLE `post` ADD COLUMN `deletedAt` DATETIME NULL DEFAULT NULL;
+CREATE INDEX IF NOT EXISTS `idx_user_deletedAt` ON `user` (`deletedAt`);
+CREATE INDEX IF NOT EXISTS `idx_post_deletedAt` ON `post` (`deletedAt`);
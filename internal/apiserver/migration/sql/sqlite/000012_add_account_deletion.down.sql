@@ -0,0 +1,3 @@
+DELETE FROM `policy` WHERE `role` = 'user' AND `path` IN ('/v1/users/:userID/export', '/v1/users/:userID/cancel-deletion');
+DROP INDEX IF EXISTS `idx_user_deletionScheduledAt`;
+ALTER TABLE `user` DROP COLUMN `deletionScheduledAt`;
@@ -0,0 +1,5 @@
+ALTER TABLE `user` ADD COLUMN `deletionScheduledAt` DATETIME NULL DEFAULT NULL;
+CREATE INDEX IF NOT EXISTS `idx_user_deletionScheduledAt` ON `user` (`deletionScheduledAt`);
+INSERT INTO `policy` (`role`, `path`, `method`) VALUES
+  ('user', '/v1/users/:userID/export', 'GET'),
+  ('user', '/v1/users/:userID/cancel-deletion', 'POST');
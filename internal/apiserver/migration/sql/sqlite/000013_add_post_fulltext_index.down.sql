@@ -0,0 +1 @@
+-- SQLite 不支持 FULLTEXT 索引，无需回滚
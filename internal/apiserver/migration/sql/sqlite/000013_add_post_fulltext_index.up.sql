@@ -0,0 +1 @@
+-- SQLite 不支持 FULLTEXT 索引，使用 SQLite 时全文检索由内嵌的 Bleve 索引实现
@@ -0,0 +1,2 @@
+-- 删除评论时会清空评论者，清理此前已删除的评论中保留的评论者
+UPDATE `comment` SET `userID` = '' WHERE `deletedAt` IS NOT NULL;
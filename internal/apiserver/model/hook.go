@@ -8,6 +8,9 @@ import (
 
 // BeforeCreate 在创建数据库记录之前加密明文密码.
 func (m *User) BeforeCreate(tx *gorm.DB) error {
+	// AfterCreate 中会用 Save 回写 userID，需要先设置好初始版本号，否则会被零值覆盖
+	m.Version = 1
+
 	// Encrypt the user password.
 	var err error
 	m.Password, err = auth.Encrypt(m.Password)
@@ -18,6 +21,13 @@ func (m *User) BeforeCreate(tx *gorm.DB) error {
 	return nil
 }
 
+// BeforeCreate 在创建数据库记录之前设置初始版本号.
+func (m *Post) BeforeCreate(tx *gorm.DB) error {
+	m.Version = 1
+
+	return nil
+}
+
 func (m *Post) AfterCreate(tx *gorm.DB) (err error) {
 	m.PostID = rid.PostID.New(uint64(m.ID))
 
@@ -30,3 +40,19 @@ func (m *User) AfterCreate(tx *gorm.DB) error {
 
 	return tx.Save(m).Error
 }
+
+// AfterCreate 在创建数据库记录之后生成 categoryID，并将其追加到分类路径中.
+// 创建前 Path 为父分类的路径，顶级分类为 "/".
+func (m *Category) AfterCreate(tx *gorm.DB) error {
+	m.CategoryID = rid.CategoryID.New(uint64(m.ID))
+	m.Path = m.Path + m.CategoryID + "/"
+
+	return tx.Save(m).Error
+}
+
+// AfterCreate 在创建数据库记录之后生成 commentID.
+func (m *Comment) AfterCreate(tx *gorm.DB) error {
+	m.CommentID = rid.CommentID.New(uint64(m.ID))
+
+	return tx.Save(m).Error
+}
@@ -6,19 +6,28 @@ package model
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
 const TableNamePost = "post"
 
 // Post 博文表
 type Post struct {
-	ID        int64     `gorm:"column:id;primaryKey;autoIncrement:true" json:"id"`
-	UserID    string    `gorm:"column:userID;not null;comment:用户唯一 ID" json:"userID"`                                    // 用户唯一 ID
-	PostID    string    `gorm:"column:postID;not null;comment:博文唯一 ID" json:"postID"`                                    // 博文唯一 ID
-	Title     string    `gorm:"column:title;not null;comment:博文标题" json:"title"`                                         // 博文标题
-	Content   string    `gorm:"column:content;not null;comment:博文内容" json:"content"`                                     // 博文内容
-	CreatedAt time.Time `gorm:"column:createdAt;not null;default:current_timestamp();comment:博文创建时间" json:"createdAt"`   // 博文创建时间
-	UpdatedAt time.Time `gorm:"column:updatedAt;not null;default:current_timestamp();comment:博文最后修改时间" json:"updatedAt"` // 博文最后修改时间
+	ID          int64          `gorm:"column:id;primaryKey;autoIncrement:true" json:"id"`
+	UserID      string         `gorm:"column:userID;not null;comment:用户唯一 ID" json:"userID"`                                               // 用户唯一 ID
+	PostID      string         `gorm:"column:postID;not null;comment:博文唯一 ID" json:"postID"`                                               // 博文唯一 ID
+	Title       string         `gorm:"column:title;not null;comment:博文标题" json:"title"`                                                    // 博文标题
+	Content     string         `gorm:"column:content;not null;comment:博文内容" json:"content"`                                                // 博文内容
+	CategoryID  string         `gorm:"column:categoryID;not null;comment:分类 ID" json:"categoryID"`                                         // 分类 ID
+	Status      string         `gorm:"column:status;not null;default:draft;comment:博文状态：draft、scheduled、published、archived" json:"status"` // 博文状态：draft、scheduled、published、archived
+	Visibility  string         `gorm:"column:visibility;not null;default:private;comment:博文可见性：private、unlisted、public" json:"visibility"` // 博文可见性：private、unlisted、public
+	PublishAt   *time.Time     `gorm:"column:publishAt;comment:定时发布时间" json:"publishAt"`                                                   // 定时发布时间
+	PublishedAt *time.Time     `gorm:"column:publishedAt;comment:博文发布时间" json:"publishedAt"`                                               // 博文发布时间
+	Version     int64          `gorm:"column:version;not null;default:1;comment:乐观锁版本号，每次更新加 1" json:"version"`                            // 乐观锁版本号，每次更新加 1
+	DeletedAt   gorm.DeletedAt `gorm:"column:deletedAt;comment:博文删除时间，删除后保留在回收站中直到被清理" json:"deletedAt"`                                   // 博文删除时间，删除后保留在回收站中直到被清理
+	CreatedAt   time.Time      `gorm:"column:createdAt;not null;default:current_timestamp();comment:博文创建时间" json:"createdAt"`              // 博文创建时间
+	UpdatedAt   time.Time      `gorm:"column:updatedAt;not null;default:current_timestamp();comment:博文最后修改时间" json:"updatedAt"`            // 博文最后修改时间
 }
 
 // TableName Post's table name
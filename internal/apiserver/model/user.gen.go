@@ -6,21 +6,27 @@ package model
 
 import (
 	"time"
+
+	"gorm.io/gorm"
 )
 
 const TableNameUser = "user"
 
 // User 用户表
 type User struct {
-	ID        int64     `gorm:"column:id;primaryKey;autoIncrement:true" json:"id"`
-	UserID    string    `gorm:"column:userID;not null;comment:用户唯一 ID" json:"userID"`                                    // 用户唯一 ID
-	Username  string    `gorm:"column:username;not null;comment:用户名（唯一）" json:"username"`                                // 用户名（唯一）
-	Password  string    `gorm:"column:password;not null;comment:用户密码（加密后）" json:"password"`                              // 用户密码（加密后）
-	Nickname  string    `gorm:"column:nickname;not null;comment:用户昵称" json:"nickname"`                                   // 用户昵称
-	Email     string    `gorm:"column:email;not null;comment:用户电子邮箱地址" json:"email"`                                     // 用户电子邮箱地址
-	Phone     string    `gorm:"column:phone;not null;comment:用户手机号" json:"phone"`                                        // 用户手机号
-	CreatedAt time.Time `gorm:"column:createdAt;not null;default:current_timestamp();comment:用户创建时间" json:"createdAt"`   // 用户创建时间
-	UpdatedAt time.Time `gorm:"column:updatedAt;not null;default:current_timestamp();comment:用户最后修改时间" json:"updatedAt"` // 用户最后修改时间
+	ID                  int64          `gorm:"column:id;primaryKey;autoIncrement:true" json:"id"`
+	UserID              string         `gorm:"column:userID;not null;comment:用户唯一 ID" json:"userID"`                                    // 用户唯一 ID
+	Username            string         `gorm:"column:username;not null;comment:用户名（唯一）" json:"username"`                                // 用户名（唯一）
+	Password            string         `gorm:"column:password;not null;comment:用户密码（加密后）" json:"password"`                              // 用户密码（加密后）
+	Nickname            string         `gorm:"column:nickname;not null;comment:用户昵称" json:"nickname"`                                   // 用户昵称
+	Email               string         `gorm:"column:email;not null;comment:用户电子邮箱地址" json:"email"`                                     // 用户电子邮箱地址
+	Phone               string         `gorm:"column:phone;not null;comment:用户手机号" json:"phone"`                                        // 用户手机号
+	Role                string         `gorm:"column:role;not null;default:user;comment:用户角色" json:"role"`                              // 用户角色
+	Version             int64          `gorm:"column:version;not null;default:1;comment:乐观锁版本号，每次更新加 1" json:"version"`                 // 乐观锁版本号，每次更新加 1
+	DeletionScheduledAt *time.Time     `gorm:"column:deletionScheduledAt;comment:账号计划删除时间，到期前用户可以取消删除" json:"deletionScheduledAt"`      // 账号计划删除时间，到期前用户可以取消删除
+	DeletedAt           gorm.DeletedAt `gorm:"column:deletedAt;comment:用户删除时间，删除后保留在回收站中直到被清理" json:"deletedAt"`                        // 用户删除时间，删除后保留在回收站中直到被清理
+	CreatedAt           time.Time      `gorm:"column:createdAt;not null;default:current_timestamp();comment:用户创建时间" json:"createdAt"`   // 用户创建时间
+	UpdatedAt           time.Time      `gorm:"column:updatedAt;not null;default:current_timestamp();comment:用户最后修改时间" json:"updatedAt"` // 用户最后修改时间
 }
 
 // TableName User's table name
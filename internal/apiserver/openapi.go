@@ -0,0 +1,122 @@
+package apiserver
+
+import (
+	"encoding/json"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/onexstack/fastgo/internal/pkg/core"
+	"github.com/onexstack/fastgo/internal/pkg/openapi"
+	v1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+	"github.com/onexstack/fastgo/pkg/token"
+	"github.com/onexstack/fastgo/pkg/version"
+)
+
+const (
+	// openAPIPath 是 OpenAPI 文档的访问路径.
+	openAPIPath = "/openapi.json"
+	// docsPath 是 Swagger UI 的访问路径.
+	docsPath = "/docs"
+)
+
+// apiRoutes 描述了 InstallRESTAPI 中注册的所有路由，用于生成 OpenAPI 文档.
+// 新增或删除路由时需要同步修改这里，否则服务启动时的路由检查会失败.
+var apiRoutes = []openapi.Route{
+	// 通用接口
+	{Method: http.MethodGet, Path: "/healthz", OperationID: "Healthz", Summary: "健康检查", Tag: "system", Response: map[string]string{}},
+	{Method: http.MethodGet, Path: "/.well-known/jwks.json", OperationID: "JWKS", Summary: "查询校验 token 使用的公钥", Tag: "system", Response: token.JWKSet{}},
+	{Method: http.MethodGet, Path: openAPIPath, OperationID: "OpenAPI", Summary: "查询 OpenAPI 文档", Tag: "system"},
+	{Method: http.MethodGet, Path: docsPath + "/*filepath", OperationID: "SwaggerUI", Summary: "Swagger UI 页面和静态文件", Tag: "system", ContentType: "text/html"},
+
+	// 认证相关接口
+	{Method: http.MethodPost, Path: "/login", OperationID: "Login", Summary: "用户登录", Tag: "auth", Request: v1.LoginRequest{}, Response: v1.LoginResponse{}},
+	{Method: http.MethodPost, Path: "/refresh-token", OperationID: "RefreshToken", Summary: "刷新令牌", Tag: "auth", Request: v1.RefreshTokenRequest{}, Response: v1.RefreshTokenResponse{}},
+	{Method: http.MethodPost, Path: "/logout", OperationID: "Logout", Summary: "退出登录", Tag: "auth", Auth: true, Response: v1.LogoutResponse{}},
+	{Method: http.MethodPost, Path: "/logout-all", OperationID: "LogoutAll", Summary: "退出所有设备上的登录", Tag: "auth", Auth: true, Response: v1.LogoutAllResponse{}},
+
+	// 用户相关接口
+	{Method: http.MethodPost, Path: "/v1/users", OperationID: "CreateUser", Summary: "创建用户", Tag: "users", Request: v1.CreateUserRequest{}, Response: v1.CreateUserResponse{}},
+	{Method: http.MethodPut, Path: "/v1/users/:userID", OperationID: "UpdateUser", Summary: "更新用户信息", Tag: "users", Auth: true, Request: v1.UpdateUserRequest{}, Response: v1.UpdateUserResponse{}},
+	{Method: http.MethodDelete, Path: "/v1/users/:userID", OperationID: "DeleteUser", Summary: "申请删除账号", Tag: "users", Auth: true, Request: v1.DeleteUserRequest{}, Response: v1.DeleteUserResponse{}},
+	{Method: http.MethodGet, Path: "/v1/users/:userID", OperationID: "GetUser", Summary: "查询用户详情", Tag: "users", Auth: true, Request: v1.GetUserRequest{}, Response: v1.GetUserResponse{}},
+	{Method: http.MethodGet, Path: "/v1/users", OperationID: "ListUser", Summary: "查询用户列表", Tag: "users", Auth: true, Request: v1.ListUserRequest{}, Response: v1.ListUserResponse{}},
+	{Method: http.MethodPut, Path: "/v1/users/:userID/change-password", OperationID: "ChangePassword", Summary: "修改密码", Tag: "users", Auth: true, Request: v1.ChangePasswordRequest{}, Response: v1.ChangePasswordResponse{}},
+	{Method: http.MethodPut, Path: "/v1/users/:userID/role", OperationID: "UpdateUserRole", Summary: "修改用户角色", Tag: "users", Auth: true, Request: v1.UpdateUserRoleRequest{}, Response: v1.UpdateUserRoleResponse{}},
+	{Method: http.MethodGet, Path: "/v1/users/trash", OperationID: "ListTrashUser", Summary: "查询回收站中的用户", Tag: "users", Auth: true, Request: v1.ListTrashUserRequest{}, Response: v1.ListTrashUserResponse{}},
+	{Method: http.MethodPost, Path: "/v1/users/:userID/restore", OperationID: "RestoreUser", Summary: "从回收站恢复用户", Tag: "users", Auth: true, Request: v1.RestoreUserRequest{}, Response: v1.RestoreUserResponse{}},
+	{Method: http.MethodPost, Path: "/v1/users/:userID/cancel-deletion", OperationID: "CancelUserDeletion", Summary: "取消删除账号", Tag: "users", Auth: true, Request: v1.CancelUserDeletionRequest{}, Response: v1.CancelUserDeletionResponse{}},
+	{Method: http.MethodGet, Path: "/v1/users/:userID/export", OperationID: "ExportUser", Summary: "导出用户的个人数据，format 为 zip 时返回 application/zip", Tag: "users", Auth: true, Request: v1.ExportUserRequest{}, Response: v1.ExportUserResponse{}},
+
+	// 博客相关接口
+	{Method: http.MethodPost, Path: "/v1/posts", OperationID: "CreatePost", Summary: "创建博客", Tag: "posts", Auth: true, Request: v1.CreatePostRequest{}, Response: v1.CreatePostResponse{}},
+	{Method: http.MethodPut, Path: "/v1/posts/:postID", OperationID: "UpdatePost", Summary: "更新博客", Tag: "posts", Auth: true, Request: v1.UpdatePostRequest{}, Response: v1.UpdatePostResponse{}},
+	{Method: http.MethodDelete, Path: "/v1/posts", OperationID: "DeletePost", Summary: "删除博客，删除的博客会移入回收站", Tag: "posts", Auth: true, Request: v1.DeletePostRequest{}, Response: v1.DeletePostResponse{}},
+	{Method: http.MethodGet, Path: "/v1/posts/trash", OperationID: "ListTrashPost", Summary: "查询回收站中的博客", Tag: "posts", Auth: true, Request: v1.ListTrashPostRequest{}, Response: v1.ListTrashPostResponse{}},
+	{Method: http.MethodGet, Path: "/v1/posts/search", OperationID: "SearchPost", Summary: "全文检索博客", Tag: "posts", Auth: true, Request: v1.SearchPostRequest{}, Response: v1.SearchPostResponse{}},
+	{Method: http.MethodPost, Path: "/v1/posts/:postID/restore", OperationID: "RestorePost", Summary: "从回收站恢复博客", Tag: "posts", Auth: true, Request: v1.RestorePostRequest{}, Response: v1.RestorePostResponse{}},
+	{Method: http.MethodGet, Path: "/v1/posts/:postID", OperationID: "GetPost", Summary: "查询博客详情", Tag: "posts", Auth: true, Request: v1.GetPostRequest{}, Response: v1.GetPostResponse{}},
+	{Method: http.MethodGet, Path: "/v1/posts", OperationID: "ListPost", Summary: "查询博客列表", Tag: "posts", Auth: true, Request: v1.ListPostRequest{}, Response: v1.ListPostResponse{}},
+	{Method: http.MethodPost, Path: "/v1/posts/:postID/publish", OperationID: "PublishPost", Summary: "发布或定时发布博客", Tag: "posts", Auth: true, Request: v1.PublishPostRequest{}, Response: v1.PublishPostResponse{}},
+	{Method: http.MethodPost, Path: "/v1/posts/:postID/unpublish", OperationID: "UnpublishPost", Summary: "撤回或归档博客", Tag: "posts", Auth: true, Request: v1.UnpublishPostRequest{}, Response: v1.UnpublishPostResponse{}},
+
+	// 评论相关接口
+	{Method: http.MethodPost, Path: "/v1/posts/:postID/comments", OperationID: "CreateComment", Summary: "发表评论", Tag: "comments", Auth: true, Request: v1.CreateCommentRequest{}, Response: v1.CreateCommentResponse{}},
+	{Method: http.MethodPut, Path: "/v1/posts/:postID/comments/:commentID", OperationID: "UpdateComment", Summary: "修改评论", Tag: "comments", Auth: true, Request: v1.UpdateCommentRequest{}, Response: v1.UpdateCommentResponse{}},
+	{Method: http.MethodDelete, Path: "/v1/posts/:postID/comments/:commentID", OperationID: "DeleteComment", Summary: "删除评论", Tag: "comments", Auth: true, Request: v1.DeleteCommentRequest{}, Response: v1.DeleteCommentResponse{}},
+	{Method: http.MethodGet, Path: "/v1/posts/:postID/comments", OperationID: "ListComment", Summary: "查询评论列表", Tag: "comments", Auth: true, Request: v1.ListCommentRequest{}, Response: v1.ListCommentResponse{}},
+
+	// 修订历史相关接口
+	{Method: http.MethodGet, Path: "/v1/posts/:postID/revisions", OperationID: "ListPostRevision", Summary: "查询博客的修订版本列表", Tag: "revisions", Auth: true, Request: v1.ListPostRevisionRequest{}, Response: v1.ListPostRevisionResponse{}},
+	{Method: http.MethodGet, Path: "/v1/posts/:postID/revisions/diff", OperationID: "DiffPostRevision", Summary: "比较两个修订版本", Tag: "revisions", Auth: true, Request: v1.DiffPostRevisionRequest{}, Response: v1.DiffPostRevisionResponse{}},
+	{Method: http.MethodGet, Path: "/v1/posts/:postID/revisions/:revision", OperationID: "GetPostRevision", Summary: "查询指定修订版本", Tag: "revisions", Auth: true, Request: v1.GetPostRevisionRequest{}, Response: v1.GetPostRevisionResponse{}},
+	{Method: http.MethodPost, Path: "/v1/posts/:postID/revisions/:revision/restore", OperationID: "RestorePostRevision", Summary: "将博客恢复为指定修订版本", Tag: "revisions", Auth: true, Request: v1.RestorePostRevisionRequest{}, Response: v1.RestorePostRevisionResponse{}},
+
+	// 标签相关接口
+	{Method: http.MethodGet, Path: "/v1/tags", OperationID: "ListTag", Summary: "查询标签列表及博客数量", Tag: "tags", Auth: true, Request: v1.ListTagRequest{}, Response: v1.ListTagResponse{}},
+	{Method: http.MethodPut, Path: "/v1/tags/:name", OperationID: "RenameTag", Summary: "重命名标签", Tag: "tags", Auth: true, Request: v1.RenameTagRequest{}, Response: v1.RenameTagResponse{}},
+	{Method: http.MethodPost, Path: "/v1/tags/merge", OperationID: "MergeTag", Summary: "合并标签", Tag: "tags", Auth: true, Request: v1.MergeTagRequest{}, Response: v1.MergeTagResponse{}},
+
+	// 分类相关接口
+	{Method: http.MethodPost, Path: "/v1/categories", OperationID: "CreateCategory", Summary: "创建分类", Tag: "categories", Auth: true, Request: v1.CreateCategoryRequest{}, Response: v1.CreateCategoryResponse{}},
+	{Method: http.MethodDelete, Path: "/v1/categories/:categoryID", OperationID: "DeleteCategory", Summary: "删除分类", Tag: "categories", Auth: true, Request: v1.DeleteCategoryRequest{}, Response: v1.DeleteCategoryResponse{}},
+	{Method: http.MethodGet, Path: "/v1/categories", OperationID: "ListCategory", Summary: "查询分类列表", Tag: "categories", Auth: true, Request: v1.ListCategoryRequest{}, Response: v1.ListCategoryResponse{}},
+
+	// 公开的只读接口
+	{Method: http.MethodGet, Path: "/v1/public/posts", OperationID: "ListPublicPost", Summary: "查询公开博客列表", Tag: "public", Request: v1.ListPublicPostRequest{}, Response: v1.ListPublicPostResponse{}},
+	{Method: http.MethodGet, Path: "/v1/public/posts/:postID", OperationID: "GetPublicPost", Summary: "查询公开博客详情", Tag: "public", Request: v1.GetPublicPostRequest{}, Response: v1.GetPublicPostResponse{}},
+	{Method: http.MethodGet, Path: "/v1/public/users/:username/posts", OperationID: "ListPublicUserPost", Summary: "查询指定作者的公开博客列表", Tag: "public", Request: v1.ListPublicPostRequest{}, Response: v1.ListPublicPostResponse{}},
+
+	// 授权策略相关接口
+	{Method: http.MethodPost, Path: "/v1/policies", OperationID: "CreatePolicy", Summary: "创建授权策略", Tag: "policies", Auth: true, Request: v1.CreatePolicyRequest{}, Response: v1.CreatePolicyResponse{}},
+	{Method: http.MethodDelete, Path: "/v1/policies", OperationID: "DeletePolicy", Summary: "删除授权策略", Tag: "policies", Auth: true, Request: v1.DeletePolicyRequest{}, Response: v1.DeletePolicyResponse{}},
+	{Method: http.MethodGet, Path: "/v1/policies", OperationID: "ListPolicy", Summary: "查询授权策略列表", Tag: "policies", Auth: true, Request: v1.ListPolicyRequest{}, Response: v1.ListPolicyResponse{}},
+
+	// 运维管理相关接口
+	{Method: http.MethodGet, Path: "/v1/admin/log-level", OperationID: "GetLogLevel", Summary: "查询当前实例的日志级别", Tag: "admin", Auth: true, Response: v1.GetLogLevelResponse{}},
+	{Method: http.MethodPut, Path: "/v1/admin/log-level", OperationID: "UpdateLogLevel", Summary: "修改当前实例的日志级别", Tag: "admin", Auth: true, Request: v1.UpdateLogLevelRequest{}, Response: v1.UpdateLogLevelResponse{}},
+}
+
+// newOpenAPIDocument 根据 apiRoutes 生成 OpenAPI 文档.
+func newOpenAPIDocument() *openapi.Document {
+	return openapi.Build(openapi.Info{
+		Title:       "fastgo API",
+		Description: "fastgo 博客系统 API. 需要认证的接口在 Authorization 请求头中携带 /login 返回的 Bearer token.",
+		Version:     version.Get().GitVersion,
+	}, core.ErrorResponse{}, apiRoutes)
+}
+
+// installOpenAPI 注册 OpenAPI 文档和 Swagger UI 路由.
+func installOpenAPI(engine *gin.Engine) {
+	data, _ := json.Marshal(newOpenAPIDocument())
+
+	engine.GET(openAPIPath, func(c *gin.Context) {
+		c.Data(http.StatusOK, "application/json; charset=utf-8", data)
+	})
+	engine.GET(docsPath+"/*filepath", openapi.UIHandler(openAPIPath))
+}
+
+// verifyOpenAPI 检查注册的路由与 OpenAPI 文档是否一致，避免文档与实际接口不符.
+func verifyOpenAPI(engine *gin.Engine) error {
+	return openapi.Verify(newOpenAPIDocument(), engine.Routes())
+}
@@ -0,0 +1,106 @@
+package apiserver
+
+import (
+	"net/http"
+	"regexp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/onexstack/fastgo/internal/apiserver/store"
+	"github.com/onexstack/fastgo/internal/pkg/testdb"
+	genericoptions "github.com/onexstack/fastgo/pkg/options"
+)
+
+// ginParamRegexp 匹配 gin 路由中的 :param 和 *param 路径参数.
+var ginParamRegexp = regexp.MustCompile(`[:*]([A-Za-z0-9_]+)`)
+
+// newTestEngine 创建注册了所有 REST API 路由的 gin 引擎. 注册路由不会访问数据库和全文检索.
+func newTestEngine(t *testing.T) *gin.Engine {
+	t.Helper()
+
+	db := testdb.New(t)
+
+	gin.SetMode(gin.TestMode)
+	engine := gin.New()
+	cfg := &Config{
+		UserOptions: genericoptions.NewUserOptions(),
+		PostOptions: genericoptions.NewPostOptions(),
+	}
+	cfg.InstallRESTAPI(engine, store.NewStore(db), nil)
+
+	return engine
+}
+
+// registeredOperations 返回 gin 中注册的路由，格式为 "METHOD /path/{param}".
+func registeredOperations(engine *gin.Engine) []string {
+	var ops []string
+	for _, route := range engine.Routes() {
+		ops = append(ops, route.Method+" "+ginParamRegexp.ReplaceAllString(route.Path, "{$1}"))
+	}
+	slices.Sort(ops)
+	return ops
+}
+
+// documentedOperations 返回 OpenAPI 文档中的操作，格式与 registeredOperations 相同.
+func documentedOperations() []string {
+	var ops []string
+	for path, item := range newOpenAPIDocument().Paths {
+		for method := range item {
+			ops = append(ops, strings.ToUpper(method)+" "+path)
+		}
+	}
+	slices.Sort(ops)
+	return ops
+}
+
+func TestOpenAPIMatchesRegisteredRoutes(t *testing.T) {
+	registered := registeredOperations(newTestEngine(t))
+	documented := documentedOperations()
+
+	if len(registered) == 0 {
+		t.Fatal("InstallRESTAPI registered no routes")
+	}
+
+	for _, op := range registered {
+		if !slices.Contains(documented, op) {
+			t.Errorf("route %s is registered but missing from the OpenAPI document, add it to apiRoutes", op)
+		}
+	}
+	for _, op := range documented {
+		if !slices.Contains(registered, op) {
+			t.Errorf("operation %s is in the OpenAPI document but not registered in InstallRESTAPI", op)
+		}
+	}
+}
+
+func TestOpenAPIOperationIDsAreUnique(t *testing.T) {
+	seen := make(map[string]string)
+	for _, route := range apiRoutes {
+		key := route.Method + " " + route.Path
+		if route.OperationID == "" {
+			t.Errorf("route %s has no operationId", key)
+			continue
+		}
+		if other, ok := seen[route.OperationID]; ok {
+			t.Errorf("operationId %s is used by both %s and %s", route.OperationID, other, key)
+		}
+		seen[route.OperationID] = key
+	}
+}
+
+func TestVerifyOpenAPI(t *testing.T) {
+	engine := newTestEngine(t)
+	if err := verifyOpenAPI(engine); err != nil {
+		t.Fatalf("verifyOpenAPI() error = %v", err)
+	}
+
+	// 新增路由但没有补充文档时校验失败
+	engine.Handle(http.MethodPatch, "/v1/posts/:postID/undocumented", func(c *gin.Context) {})
+	err := verifyOpenAPI(engine)
+	if err == nil || !strings.Contains(err.Error(), "PATCH /v1/posts/:postID/undocumented") {
+		t.Fatalf("verifyOpenAPI() error = %v, want the undocumented route to be reported", err)
+	}
+}
@@ -0,0 +1,68 @@
+package authz
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+
+	"github.com/onexstack/fastgo/internal/apiserver/store"
+	"github.com/onexstack/fastgo/internal/pkg/errorsx"
+	mw "github.com/onexstack/fastgo/internal/pkg/middleware"
+)
+
+// Wildcard 表示匹配任意路由路径或 HTTP 方法.
+const Wildcard = "*"
+
+// Authz 是基于角色和数据库中授权策略的授权器.
+type Authz struct {
+	store store.IStore
+}
+
+// 确保 Authz 实现了 mw.Authorizer 接口.
+var _ mw.Authorizer = (*Authz)(nil)
+
+// New 创建 Authz 的实例.
+func New(store store.IStore) *Authz {
+	return &Authz{store: store}
+}
+
+// Authorize 查询用户的角色及该角色的授权策略，判断用户是否有权限访问指定路由.
+// 策略每次请求都从数据库中读取，修改后立即生效，多个实例之间也无需同步.
+func (a *Authz) Authorize(ctx context.Context, userID string, path string, method string) (string, error) {
+	userM, err := a.store.User().Get(ctx, where.F("userID", userID))
+	if err != nil {
+		if errors.Is(err, errorsx.ErrUserNotFound) {
+			return "", errorsx.ErrPermissionDenied
+		}
+		return "", err
+	}
+
+	_, policies, err := a.store.Policy().List(ctx, where.F("role", userM.Role))
+	if err != nil {
+		return "", err
+	}
+
+	for _, policy := range policies {
+		if Match(policy.Path, path) && (policy.Method == Wildcard || strings.EqualFold(policy.Method, method)) {
+			return userM.Role, nil
+		}
+	}
+
+	return "", errorsx.ErrPermissionDenied
+}
+
+// Match 判断路由路径是否匹配策略中的路径.
+// 策略路径为 * 时匹配所有路径，以 * 结尾时按前缀匹配，否则需要完全相等.
+func Match(pattern string, path string) bool {
+	if pattern == Wildcard {
+		return true
+	}
+
+	if prefix, ok := strings.CutSuffix(pattern, Wildcard); ok {
+		return strings.HasPrefix(path, prefix)
+	}
+
+	return pattern == path
+}
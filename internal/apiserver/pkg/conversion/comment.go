@@ -0,0 +1,22 @@
+package conversion
+
+import (
+	"github.com/onexstack/onexstack/pkg/core"
+
+	"github.com/onexstack/fastgo/internal/apiserver/model"
+	"github.com/onexstack/fastgo/internal/pkg/known"
+	apiv1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+)
+
+// CommentModelToCommentV1 将模型层的 Comment（评论模型对象）转换为 Protobuf 层的 Comment（v1 评论对象）.
+// 已删除的评论只保留在评论树中的位置，不返回评论者和评论内容.
+func CommentModelToCommentV1(commentModel *model.Comment) *apiv1.Comment {
+	var protoComment apiv1.Comment
+	_ = core.CopyWithConverters(&protoComment, commentModel)
+	if commentModel.DeletedAt != nil {
+		protoComment.Deleted = true
+		protoComment.UserID = ""
+		protoComment.Content = known.DeletedCommentPlaceholder
+	}
+	return &protoComment
+}
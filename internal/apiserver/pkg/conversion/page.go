@@ -0,0 +1,84 @@
+package conversion
+
+import (
+	"encoding/json"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+
+	"github.com/onexstack/fastgo/internal/apiserver/store"
+	"github.com/onexstack/fastgo/internal/pkg/errorsx"
+	"github.com/onexstack/fastgo/internal/pkg/known"
+	"github.com/onexstack/fastgo/internal/pkg/pagination"
+	"github.com/onexstack/fastgo/internal/pkg/query"
+	apiv1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+)
+
+// ListRequestToOptions 将列表请求中的过滤、排序和分页参数转换为 store 层的查询条件和分页参数.
+// 过滤条件会追加到 whr 中. scope 标识列表接口和过滤条件，分页令牌只能用于签发它的查询.
+func ListRequestToOptions(whr *where.Options, rq *apiv1.PageRequest, q *apiv1.QueryRequest, schema *query.Schema, scope string) (*store.PageOptions, error) {
+	filter, err := schema.Filter(q.Filter)
+	if err != nil {
+		return nil, errorsx.New(errorsx.ErrInvalidArgument.Code, errorsx.ErrInvalidArgument.Reason, "%s", err.Error())
+	}
+	if filter != nil {
+		whr.C(filter)
+	}
+
+	sortBy, order := rq.SortBy, rq.Order
+	if q.OrderBy != "" {
+		if sortBy, order, err = schema.OrderBy(q.OrderBy); err != nil {
+			return nil, errorsx.New(errorsx.ErrInvalidArgument.Code, errorsx.ErrInvalidArgument.Reason, "%s", err.Error())
+		}
+	}
+	if sortBy == "" {
+		sortBy = schema.DefaultSort
+	}
+
+	opts := &store.PageOptions{
+		SortBy:    schema.Fields[sortBy].Column,
+		Desc:      schema.DefaultDesc,
+		Limit:     int(rq.Limit),
+		WithTotal: rq.WithTotal,
+	}
+	switch order {
+	case known.SortOrderAsc:
+		opts.Desc = false
+	case known.SortOrderDesc:
+		opts.Desc = true
+	}
+	if opts.Limit <= 0 {
+		opts.Limit = known.DefaultPageSize
+	}
+
+	if rq.PageToken == "" {
+		return opts, nil
+	}
+
+	token, err := pagination.Decode(rq.PageToken)
+	if err != nil {
+		return nil, errorsx.ErrInvalidPageToken
+	}
+	// 排序方式或过滤条件变化后，令牌中的位置不再有意义
+	if token.SortBy != opts.SortBy || token.Desc != opts.Desc || token.Scope != pagination.Scope(scope) {
+		return nil, errorsx.ErrInvalidPageToken
+	}
+	opts.After = token
+
+	return opts, nil
+}
+
+// NextPageToken 根据 store 层返回的下一页位置签发分页令牌，没有下一页时返回空字符串.
+func NextPageToken(next *pagination.Token, scope string) string {
+	if next == nil {
+		return ""
+	}
+
+	next.Scope = pagination.Scope(scope)
+	return pagination.Encode(next)
+}
+
+// PageScope 根据列表接口名称和过滤条件生成分页令牌的查询标识.
+func PageScope(name string, filters ...any) string {
+	data, _ := json.Marshal(filters)
+	return name + string(data)
+}
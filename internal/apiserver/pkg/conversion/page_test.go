@@ -0,0 +1,132 @@
+package conversion
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+
+	"github.com/onexstack/fastgo/internal/apiserver/store"
+	"github.com/onexstack/fastgo/internal/pkg/errorsx"
+	"github.com/onexstack/fastgo/internal/pkg/pagination"
+	apiv1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+)
+
+// firstPageToken 模拟第一页查询，返回签发给客户端的分页令牌.
+func firstPageToken(t *testing.T, rq *apiv1.PageRequest, q *apiv1.QueryRequest) string {
+	t.Helper()
+
+	opts, err := ListRequestToOptions(where.NewWhere(), rq, q, store.UserSchema, PageScope("users", q.Filter))
+	if err != nil {
+		t.Fatalf("ListRequestToOptions() error = %v", err)
+	}
+
+	next := &pagination.Token{SortBy: opts.SortBy, Desc: opts.Desc, Value: json.RawMessage(`"2024-01-01T00:00:00Z"`), ID: 10}
+	return NextPageToken(next, PageScope("users", q.Filter))
+}
+
+func TestListRequestToOptionsPageToken(t *testing.T) {
+	first := &apiv1.PageRequest{Limit: 2}
+	query := &apiv1.QueryRequest{Filter: "role=user", OrderBy: "updatedAt desc"}
+	token := firstPageToken(t, first, query)
+
+	tests := []struct {
+		name    string
+		scope   string
+		rq      apiv1.PageRequest
+		q       apiv1.QueryRequest
+		wantErr bool
+	}{
+		{
+			name: "same query",
+			rq:   apiv1.PageRequest{PageToken: token, Limit: 2},
+			q:    apiv1.QueryRequest{Filter: "role=user", OrderBy: "updatedAt desc"},
+		},
+		{
+			name: "same query with a different limit",
+			rq:   apiv1.PageRequest{PageToken: token, Limit: 50},
+			q:    apiv1.QueryRequest{Filter: "role=user", OrderBy: "updatedAt desc"},
+		},
+		{
+			name:    "different filter",
+			rq:      apiv1.PageRequest{PageToken: token, Limit: 2},
+			q:       apiv1.QueryRequest{Filter: "role=admin", OrderBy: "updatedAt desc"},
+			wantErr: true,
+		},
+		{
+			name:    "filter removed",
+			rq:      apiv1.PageRequest{PageToken: token, Limit: 2},
+			q:       apiv1.QueryRequest{OrderBy: "updatedAt desc"},
+			wantErr: true,
+		},
+		{
+			name:    "different sort field",
+			rq:      apiv1.PageRequest{PageToken: token, Limit: 2},
+			q:       apiv1.QueryRequest{Filter: "role=user", OrderBy: "createdAt desc"},
+			wantErr: true,
+		},
+		{
+			name:    "different sort order",
+			rq:      apiv1.PageRequest{PageToken: token, Limit: 2},
+			q:       apiv1.QueryRequest{Filter: "role=user", OrderBy: "updatedAt asc"},
+			wantErr: true,
+		},
+		{
+			name:    "sort field falls back to the default",
+			rq:      apiv1.PageRequest{PageToken: token, Limit: 2},
+			q:       apiv1.QueryRequest{Filter: "role=user"},
+			wantErr: true,
+		},
+		{
+			name:    "different list",
+			scope:   PageScope("trashed-users", "role=user"),
+			rq:      apiv1.PageRequest{PageToken: token, Limit: 2},
+			q:       apiv1.QueryRequest{Filter: "role=user", OrderBy: "updatedAt desc"},
+			wantErr: true,
+		},
+		{
+			name:    "tampered token",
+			rq:      apiv1.PageRequest{PageToken: token + "x", Limit: 2},
+			q:       apiv1.QueryRequest{Filter: "role=user", OrderBy: "updatedAt desc"},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scope := tt.scope
+			if scope == "" {
+				scope = PageScope("users", tt.q.Filter)
+			}
+
+			opts, err := ListRequestToOptions(where.NewWhere(), &tt.rq, &tt.q, store.UserSchema, scope)
+			if tt.wantErr {
+				if !errors.Is(err, errorsx.ErrInvalidPageToken) {
+					t.Fatalf("ListRequestToOptions() error = %v, want ErrInvalidPageToken", err)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ListRequestToOptions() error = %v", err)
+			}
+			if opts.After == nil || opts.After.ID != 10 {
+				t.Errorf("ListRequestToOptions() After = %+v, want the position from the token", opts.After)
+			}
+		})
+	}
+}
+
+func TestListRequestToOptionsDefaults(t *testing.T) {
+	opts, err := ListRequestToOptions(where.NewWhere(), &apiv1.PageRequest{}, &apiv1.QueryRequest{}, store.UserSchema, PageScope("users", ""))
+	if err != nil {
+		t.Fatalf("ListRequestToOptions() error = %v", err)
+	}
+	if opts.SortBy != "createdAt" || !opts.Desc || opts.After != nil || opts.Limit <= 0 {
+		t.Errorf("ListRequestToOptions() = %+v, want the default sort and first page", opts)
+	}
+
+	if NextPageToken(nil, "users") != "" {
+		t.Error("NextPageToken(nil) should be empty when there is no next page")
+	}
+}
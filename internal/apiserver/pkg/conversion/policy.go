@@ -0,0 +1,15 @@
+package conversion
+
+import (
+	"github.com/onexstack/onexstack/pkg/core"
+
+	"github.com/onexstack/fastgo/internal/apiserver/model"
+	apiv1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+)
+
+// PolicyModelToPolicyV1 将模型层的 Policy（授权策略模型对象）转换为 Protobuf 层的 Policy（v1 授权策略对象）.
+func PolicyModelToPolicyV1(policyModel *model.Policy) *apiv1.Policy {
+	var protoPolicy apiv1.Policy
+	_ = core.CopyWithConverters(&protoPolicy, policyModel)
+	return &protoPolicy
+}
@@ -11,6 +11,9 @@ import (
 func PostodelToPostV1(postModel *model.Post) *apiv1.Post {
 	var protoPost apiv1.Post
 	_ = core.CopyWithConverters(&protoPost, postModel)
+	if postModel.DeletedAt.Valid {
+		protoPost.DeletedAt = &postModel.DeletedAt.Time
+	}
 	return &protoPost
 }
 
@@ -20,3 +23,17 @@ func PostV1ToPostodel(protoPost *apiv1.Post) *model.Post {
 	_ = core.CopyWithConverters(&postModel, protoPost)
 	return &postModel
 }
+
+// PostModelToPublicPostV1 将模型层的 Post 转换为公开展示的 PublicPost，author 为作者的用户名.
+func PostModelToPublicPostV1(postModel *model.Post, author string) *apiv1.PublicPost {
+	publicPost := apiv1.PublicPost{
+		PostID:  postModel.PostID,
+		Author:  author,
+		Title:   postModel.Title,
+		Content: postModel.Content,
+	}
+	if postModel.PublishedAt != nil {
+		publicPost.PublishedAt = *postModel.PublishedAt
+	}
+	return &publicPost
+}
@@ -0,0 +1,25 @@
+package conversion
+
+import (
+	"github.com/onexstack/onexstack/pkg/core"
+
+	"github.com/onexstack/fastgo/internal/apiserver/model"
+	"github.com/onexstack/fastgo/internal/pkg/diff"
+	apiv1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+)
+
+// PostRevisionModelToPostRevisionV1 将模型层的 PostRevision（修订版本模型对象）转换为 Protobuf 层的 PostRevision（v1 修订版本对象）.
+func PostRevisionModelToPostRevisionV1(revisionModel *model.PostRevision) *apiv1.PostRevision {
+	var protoRevision apiv1.PostRevision
+	_ = core.CopyWithConverters(&protoRevision, revisionModel)
+	return &protoRevision
+}
+
+// DiffLinesToDiffLinesV1 将逐行差异转换为 v1 差异行列表.
+func DiffLinesToDiffLinesV1(lines []diff.Line) []*apiv1.DiffLine {
+	protoLines := make([]*apiv1.DiffLine, 0, len(lines))
+	for _, line := range lines {
+		protoLines = append(protoLines, &apiv1.DiffLine{Op: string(line.Op), Text: line.Text})
+	}
+	return protoLines
+}
@@ -11,6 +11,9 @@ import (
 func UserodelToUserV1(userModel *model.User) *apiv1.User {
 	var protoUser apiv1.User
 	_ = core.CopyWithConverters(&protoUser, userModel)
+	if userModel.DeletedAt.Valid {
+		protoUser.DeletedAt = &userModel.DeletedAt.Time
+	}
 	return &protoUser
 }
 
@@ -0,0 +1,30 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/onexstack/fastgo/internal/pkg/log"
+	v1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+)
+
+func (v *Validator) ValidateUpdateLogLevelRequest(ctx context.Context, rq *v1.UpdateLogLevelRequest) error {
+	if rq.Level == "" && rq.Levels == nil {
+		return errors.New("at least one of level and levels is required")
+	}
+
+	if rq.Level != "" {
+		if _, err := log.ParseLevel(rq.Level); err != nil {
+			return err
+		}
+	}
+
+	for pkg, level := range rq.Levels {
+		if _, err := log.ParseLevel(level); err != nil {
+			return fmt.Errorf("package '%s': %w", pkg, err)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,35 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/onexstack/fastgo/internal/apiserver/store"
+	v1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+)
+
+// maxCategoryNameLength 定义了分类名称的最大长度.
+const maxCategoryNameLength = 64
+
+func (v *Validator) ValidateCreateCategoryRequest(ctx context.Context, rq *v1.CreateCategoryRequest) error {
+	if strings.TrimSpace(rq.Name) == "" {
+		return errors.New("name cannot be empty")
+	}
+
+	if utf8.RuneCountInString(rq.Name) > maxCategoryNameLength {
+		return fmt.Errorf("name must be at most %d characters", maxCategoryNameLength)
+	}
+
+	return nil
+}
+
+func (v *Validator) ValidateDeleteCategoryRequest(ctx context.Context, rq *v1.DeleteCategoryRequest) error {
+	return nil
+}
+
+func (v *Validator) ValidateListCategoryRequest(ctx context.Context, rq *v1.ListCategoryRequest) error {
+	return validateListRequest(&rq.PageRequest, &rq.QueryRequest, store.CategorySchema, v1.Category{})
+}
@@ -0,0 +1,44 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/onexstack/fastgo/internal/apiserver/store"
+	v1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+)
+
+// maxCommentLength 定义了评论内容的最大长度.
+const maxCommentLength = 4096
+
+func (v *Validator) ValidateCreateCommentRequest(ctx context.Context, rq *v1.CreateCommentRequest) error {
+	return validateCommentContent(rq.Content)
+}
+
+func (v *Validator) ValidateUpdateCommentRequest(ctx context.Context, rq *v1.UpdateCommentRequest) error {
+	return validateCommentContent(rq.Content)
+}
+
+func (v *Validator) ValidateDeleteCommentRequest(ctx context.Context, rq *v1.DeleteCommentRequest) error {
+	return nil
+}
+
+func (v *Validator) ValidateListCommentRequest(ctx context.Context, rq *v1.ListCommentRequest) error {
+	return validateListRequest(&rq.PageRequest, &rq.QueryRequest, store.CommentSchema, v1.Comment{})
+}
+
+// validateCommentContent 校验评论内容是否合法.
+func validateCommentContent(content string) error {
+	if strings.TrimSpace(content) == "" {
+		return errors.New("content cannot be empty")
+	}
+
+	if utf8.RuneCountInString(content) > maxCommentLength {
+		return fmt.Errorf("content must be at most %d characters", maxCommentLength)
+	}
+
+	return nil
+}
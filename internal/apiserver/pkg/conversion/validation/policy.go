@@ -0,0 +1,53 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"github.com/onexstack/fastgo/internal/apiserver/store"
+	"github.com/onexstack/fastgo/internal/pkg/known"
+	v1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+)
+
+// validMethods 定义了授权策略中允许使用的 HTTP 方法.
+var validMethods = []string{"*", "GET", "POST", "PUT", "PATCH", "DELETE"}
+
+func (v *Validator) ValidateCreatePolicyRequest(ctx context.Context, rq *v1.CreatePolicyRequest) error {
+	return validatePolicy(rq.Role, rq.Path, rq.Method)
+}
+
+func (v *Validator) ValidateDeletePolicyRequest(ctx context.Context, rq *v1.DeletePolicyRequest) error {
+	return validatePolicy(rq.Role, rq.Path, rq.Method)
+}
+
+func (v *Validator) ValidateListPolicyRequest(ctx context.Context, rq *v1.ListPolicyRequest) error {
+	return validateListRequest(&rq.PageRequest, &rq.QueryRequest, store.PolicySchema, v1.Policy{})
+}
+
+// validatePolicy 校验授权策略的角色、路由路径和 HTTP 方法.
+func validatePolicy(role string, path string, method string) error {
+	if err := validateRole(role); err != nil {
+		return err
+	}
+
+	if path != "*" && !strings.HasPrefix(path, "/") {
+		return errors.New("path must be '*' or start with '/'")
+	}
+
+	if !slices.Contains(validMethods, strings.ToUpper(method)) {
+		return errors.New("method must be one of " + strings.Join(validMethods, ", "))
+	}
+
+	return nil
+}
+
+// validateRole 校验角色名称是否合法.
+func validateRole(role string) error {
+	if role != known.RoleAdmin && role != known.RoleUser {
+		return errors.New("role must be one of " + known.RoleAdmin + ", " + known.RoleUser)
+	}
+
+	return nil
+}
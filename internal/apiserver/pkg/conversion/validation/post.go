@@ -2,15 +2,63 @@ package validation
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
 
+	"github.com/onexstack/fastgo/internal/apiserver/store"
+	"github.com/onexstack/fastgo/internal/pkg/known"
 	v1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
 )
 
+var (
+	// validPostStatuses 定义了合法的博客状态.
+	validPostStatuses = []string{known.PostStatusDraft, known.PostStatusScheduled, known.PostStatusPublished, known.PostStatusArchived}
+	// validPostVisibilities 定义了合法的博客可见性.
+	validPostVisibilities = []string{known.PostVisibilityPrivate, known.PostVisibilityUnlisted, known.PostVisibilityPublic}
+)
+
 func (v *Validator) ValidateCreatePostRequest(ctx context.Context, rq *v1.CreatePostRequest) error {
+	if err := validateContent(rq.Content); err != nil {
+		return err
+	}
+
+	if err := validateTags(rq.Tags); err != nil {
+		return err
+	}
+
+	if rq.Visibility != nil {
+		if err := validateVisibility(*rq.Visibility); err != nil {
+			return err
+		}
+	}
+
+	if rq.PublishAt != nil && !rq.PublishAt.After(time.Now()) {
+		return fmt.Errorf("publishAt must be in the future")
+	}
+
 	return nil
 }
 
 func (v *Validator) ValidateUpdatePostRequest(ctx context.Context, rq *v1.UpdatePostRequest) error {
+	if rq.Content != nil {
+		if err := validateContent(*rq.Content); err != nil {
+			return err
+		}
+	}
+
+	if rq.Tags != nil {
+		if err := validateTags(*rq.Tags); err != nil {
+			return err
+		}
+	}
+
+	if rq.Visibility != nil {
+		return validateVisibility(*rq.Visibility)
+	}
+
 	return nil
 }
 
@@ -23,5 +71,80 @@ func (v *Validator) ValidateGetPostRequest(ctx context.Context, rq *v1.GetPostRe
 }
 
 func (v *Validator) ValidateListPostRequest(ctx context.Context, rq *v1.ListPostRequest) error {
+	if rq.Status != nil && !slices.Contains(validPostStatuses, *rq.Status) {
+		return fmt.Errorf("invalid status '%s', must be one of %v", *rq.Status, validPostStatuses)
+	}
+
+	if rq.TagMode != "" && rq.TagMode != known.TagModeAny && rq.TagMode != known.TagModeAll {
+		return fmt.Errorf("invalid tagMode '%s', must be one of %s, %s", rq.TagMode, known.TagModeAny, known.TagModeAll)
+	}
+
+	return validateListRequest(&rq.PageRequest, &rq.QueryRequest, store.PostSchema, v1.Post{})
+}
+
+func (v *Validator) ValidateSearchPostRequest(ctx context.Context, rq *v1.SearchPostRequest) error {
+	if strings.TrimSpace(rq.Q) == "" {
+		return errors.New("q cannot be empty")
+	}
+
+	if len(rq.Q) > known.MaxSearchQueryLength {
+		return fmt.Errorf("q must be at most %d characters long", known.MaxSearchQueryLength)
+	}
+
+	if rq.Limit < 0 || rq.Limit > known.MaxPageSize {
+		return fmt.Errorf("limit must be between 0 and %d", known.MaxPageSize)
+	}
+
+	return nil
+}
+
+func (v *Validator) ValidatePublishPostRequest(ctx context.Context, rq *v1.PublishPostRequest) error {
+	return nil
+}
+
+func (v *Validator) ValidateUnpublishPostRequest(ctx context.Context, rq *v1.UnpublishPostRequest) error {
+	return nil
+}
+
+func (v *Validator) ValidateListPublicPostRequest(ctx context.Context, rq *v1.ListPublicPostRequest) error {
+	if err := validateListRequest(&rq.PageRequest, &rq.QueryRequest, store.PublicPostSchema, v1.PublicPost{}); err != nil {
+		return err
+	}
+
+	// 公开博客列表只支持按照发布时间倒序排列
+	if rq.Order == known.SortOrderAsc {
+		return fmt.Errorf("order must be %s", known.SortOrderDesc)
+	}
+
+	return nil
+}
+
+func (v *Validator) ValidateGetPublicPostRequest(ctx context.Context, rq *v1.GetPublicPostRequest) error {
+	return nil
+}
+
+// validateContent 校验博客内容的长度，过长的内容会使修订版本的存储和差异比较占用过多资源.
+func validateContent(content string) error {
+	if len(content) > known.MaxPostContentLength {
+		return fmt.Errorf("content must be at most %d bytes long", known.MaxPostContentLength)
+	}
+
+	return nil
+}
+
+// validateVisibility 校验博客可见性是否合法.
+func validateVisibility(visibility string) error {
+	if !slices.Contains(validPostVisibilities, visibility) {
+		return fmt.Errorf("invalid visibility '%s', must be one of %v", visibility, validPostVisibilities)
+	}
+
+	return nil
+}
+
+func (v *Validator) ValidateListTrashPostRequest(ctx context.Context, rq *v1.ListTrashPostRequest) error {
+	return validateListRequest(&rq.PageRequest, &rq.QueryRequest, store.TrashedPostSchema, v1.Post{})
+}
+
+func (v *Validator) ValidateRestorePostRequest(ctx context.Context, rq *v1.RestorePostRequest) error {
 	return nil
 }
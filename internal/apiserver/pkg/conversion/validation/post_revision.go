@@ -0,0 +1,38 @@
+package validation
+
+import (
+	"context"
+	"errors"
+
+	"github.com/onexstack/fastgo/internal/apiserver/store"
+	v1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+)
+
+func (v *Validator) ValidateListPostRevisionRequest(ctx context.Context, rq *v1.ListPostRevisionRequest) error {
+	return validateListRequest(&rq.PageRequest, &rq.QueryRequest, store.PostRevisionSchema, v1.PostRevision{})
+}
+
+func (v *Validator) ValidateGetPostRevisionRequest(ctx context.Context, rq *v1.GetPostRevisionRequest) error {
+	return validateRevision(rq.Revision)
+}
+
+func (v *Validator) ValidateDiffPostRevisionRequest(ctx context.Context, rq *v1.DiffPostRevisionRequest) error {
+	if rq.From <= 0 || rq.To <= 0 {
+		return errors.New("from and to must be positive revision numbers")
+	}
+
+	return nil
+}
+
+func (v *Validator) ValidateRestorePostRevisionRequest(ctx context.Context, rq *v1.RestorePostRevisionRequest) error {
+	return validateRevision(rq.Revision)
+}
+
+// validateRevision 校验修订版本号是否合法，版本号从 1 开始.
+func validateRevision(revision int64) error {
+	if revision <= 0 {
+		return errors.New("revision must be a positive number")
+	}
+
+	return nil
+}
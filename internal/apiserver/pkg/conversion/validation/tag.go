@@ -0,0 +1,61 @@
+package validation
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"unicode/utf8"
+
+	tagv1 "github.com/onexstack/fastgo/internal/apiserver/biz/v1/tag"
+	"github.com/onexstack/fastgo/internal/apiserver/store"
+	v1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+)
+
+// maxTagLength 定义了标签名称的最大长度.
+const maxTagLength = 64
+
+func (v *Validator) ValidateListTagRequest(ctx context.Context, rq *v1.ListTagRequest) error {
+	return validateListRequest(&rq.PageRequest, &rq.QueryRequest, store.TagSchema, v1.Tag{})
+}
+
+func (v *Validator) ValidateRenameTagRequest(ctx context.Context, rq *v1.RenameTagRequest) error {
+	return validateTag(rq.NewName)
+}
+
+func (v *Validator) ValidateMergeTagRequest(ctx context.Context, rq *v1.MergeTagRequest) error {
+	if len(rq.Sources) == 0 {
+		return errors.New("sources cannot be empty")
+	}
+
+	return validateTags(append([]string{rq.Target}, rq.Sources...))
+}
+
+// validateTags 校验标签名称列表是否合法.
+func validateTags(names []string) error {
+	for _, name := range names {
+		if err := validateTag(name); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// validateTag 校验标签名称是否合法.
+func validateTag(name string) error {
+	name = tagv1.Normalize(name)
+	if name == "" {
+		return errors.New("tag name cannot be empty")
+	}
+
+	if utf8.RuneCountInString(name) > maxTagLength {
+		return fmt.Errorf("tag name must be at most %d characters", maxTagLength)
+	}
+
+	if strings.ContainsAny(name, ",/") {
+		return fmt.Errorf("tag name '%s' cannot contain ',' or '/'", name)
+	}
+
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 
+	"github.com/onexstack/fastgo/internal/apiserver/store"
+	"github.com/onexstack/fastgo/internal/pkg/known"
 	v1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
 )
 
@@ -20,6 +22,10 @@ func (v *Validator) ValidateLoginRequest(ctx context.Context, rq *v1.LoginReques
 }
 
 func (v *Validator) ValidateRefreshTokenRequest(ctx context.Context, rq *v1.RefreshTokenRequest) error {
+	if rq.RefreshToken == "" {
+		return errors.New("refresh token cannot be empty")
+	}
+
 	return nil
 }
 
@@ -79,5 +85,30 @@ func (v *Validator) ValidateGetUserRequest(ctx context.Context, rq *v1.GetUserRe
 }
 
 func (v *Validator) ValidateListUserRequest(ctx context.Context, rq *v1.ListUserRequest) error {
+	return validateListRequest(&rq.PageRequest, &rq.QueryRequest, store.UserSchema, v1.User{})
+}
+
+func (v *Validator) ValidateUpdateUserRoleRequest(ctx context.Context, rq *v1.UpdateUserRoleRequest) error {
+	return validateRole(rq.Role)
+}
+
+func (v *Validator) ValidateListTrashUserRequest(ctx context.Context, rq *v1.ListTrashUserRequest) error {
+	return validateListRequest(&rq.PageRequest, &rq.QueryRequest, store.TrashedUserSchema, v1.User{})
+}
+
+func (v *Validator) ValidateRestoreUserRequest(ctx context.Context, rq *v1.RestoreUserRequest) error {
+	return nil
+}
+
+func (v *Validator) ValidateCancelUserDeletionRequest(ctx context.Context, rq *v1.CancelUserDeletionRequest) error {
 	return nil
 }
+
+func (v *Validator) ValidateExportUserRequest(ctx context.Context, rq *v1.ExportUserRequest) error {
+	switch rq.Format {
+	case "", known.ExportFormatJSON, known.ExportFormatZIP:
+		return nil
+	default:
+		return errors.New("format must be one of: json, zip")
+	}
+}
@@ -1,7 +1,13 @@
 package validation
 
 import (
+	"errors"
+	"fmt"
+
 	"github.com/onexstack/fastgo/internal/apiserver/store"
+	"github.com/onexstack/fastgo/internal/pkg/known"
+	"github.com/onexstack/fastgo/internal/pkg/query"
+	v1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
 )
 
 type Validator struct {
@@ -11,3 +17,40 @@ type Validator struct {
 func NewValidator(store store.IStore) *Validator {
 	return &Validator{store: &store}
 }
+
+// validateListRequest 校验列表接口的分页、过滤、排序和字段选择参数.
+// schema 定义了该列表接口允许过滤和排序的字段，item 是列表中的对象类型，用于校验返回字段.
+func validateListRequest(rq *v1.PageRequest, q *v1.QueryRequest, schema *query.Schema, item any) error {
+	if rq.Limit < 0 || rq.Limit > known.MaxPageSize {
+		return fmt.Errorf("limit must be between 0 and %d", known.MaxPageSize)
+	}
+
+	if rq.SortBy != "" {
+		if field, ok := schema.Fields[rq.SortBy]; !ok || !field.Sortable {
+			return fmt.Errorf("invalid sortBy '%s', must be one of %v", rq.SortBy, schema.Sortable())
+		}
+	}
+
+	if rq.Order != "" && rq.Order != known.SortOrderAsc && rq.Order != known.SortOrderDesc {
+		return fmt.Errorf("invalid order '%s', must be one of %s, %s", rq.Order, known.SortOrderAsc, known.SortOrderDesc)
+	}
+
+	if q.OrderBy != "" {
+		if rq.SortBy != "" || rq.Order != "" {
+			return errors.New("orderBy cannot be used together with sortBy or order")
+		}
+		if _, _, err := schema.OrderBy(q.OrderBy); err != nil {
+			return err
+		}
+	}
+
+	if _, err := schema.Filter(q.Filter); err != nil {
+		return err
+	}
+
+	if _, err := query.Fields(q.Fields, item); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,58 @@
+package apiserver
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"github.com/onexstack/fastgo/internal/apiserver/biz"
+	postv1 "github.com/onexstack/fastgo/internal/apiserver/biz/v1/post"
+	genericoptions "github.com/onexstack/fastgo/pkg/options"
+)
+
+// postPublishInterval 是检查到期定时发布博客的时间间隔.
+const postPublishInterval = 10 * time.Second
+
+// runPostPublisher 定期将到期的定时发布博客改为已发布状态，直到 ctx 被取消.
+func runPostPublisher(ctx context.Context, post postv1.PostBiz) {
+	ticker := time.NewTicker(postPublishInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := post.PublishScheduled(ctx); err != nil {
+			slog.ErrorContext(ctx, "Failed to publish scheduled posts", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// runTrashPurger 定期删除宽限期已结束的账号，并永久删除回收站中超过保留时间的博客和用户，直到 ctx 被取消.
+func runTrashPurger(ctx context.Context, biz biz.IBiz, opts *genericoptions.TrashOptions) {
+	ticker := time.NewTicker(opts.PurgeInterval)
+	defer ticker.Stop()
+
+	for {
+		if _, err := biz.UserV1().DeleteScheduled(ctx); err != nil {
+			slog.ErrorContext(ctx, "Failed to delete scheduled accounts", "err", err)
+		}
+
+		before := time.Now().Add(-opts.Retention)
+		if _, err := biz.PostV1().PurgeTrash(ctx, before); err != nil {
+			slog.ErrorContext(ctx, "Failed to purge trashed posts", "err", err)
+		}
+		if _, err := biz.UserV1().PurgeTrash(ctx, before); err != nil {
+			slog.ErrorContext(ctx, "Failed to purge trashed users", "err", err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
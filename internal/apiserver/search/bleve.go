@@ -0,0 +1,181 @@
+package search
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/blevesearch/bleve/v2"
+	"github.com/blevesearch/bleve/v2/analysis/analyzer/keyword"
+	"github.com/blevesearch/bleve/v2/analysis/lang/cjk"
+	"github.com/blevesearch/bleve/v2/mapping"
+	"github.com/blevesearch/bleve/v2/search/highlight/highlighter/html"
+	"github.com/blevesearch/bleve/v2/search/query"
+	"gorm.io/gorm"
+
+	"github.com/onexstack/fastgo/internal/apiserver/model"
+)
+
+// rebuildBatchSize 是重建索引时每批读取和写入的博客数量.
+const rebuildBatchSize = 500
+
+// fieldUserID 是索引中记录博客作者的字段，只用于过滤，不参与相关度计算.
+const fieldUserID = "userID"
+
+// titleBoost 是标题匹配相对于内容匹配的权重.
+const titleBoost = 2.0
+
+// bleveIndex 是基于 Bleve 倒排索引的 Index 实现.
+type bleveIndex struct {
+	index bleve.Index
+}
+
+// 确保 bleveIndex 实现了 Index 接口.
+var _ Index = (*bleveIndex)(nil)
+
+// newBleveIndex 打开 path 中的 Bleve 索引，path 为空时创建内存索引.
+// 内存索引和新建的索引是空的，会根据数据库中的博客重建.
+func newBleveIndex(path string, db *gorm.DB) (*bleveIndex, error) {
+	var (
+		index   bleve.Index
+		err     error
+		rebuild = true
+	)
+	switch {
+	case path == "":
+		index, err = bleve.NewMemOnly(newMapping())
+	default:
+		index, err = bleve.Open(path)
+		if errors.Is(err, bleve.ErrorIndexPathDoesNotExist) {
+			index, err = bleve.New(path, newMapping())
+		} else {
+			rebuild = false
+		}
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	b := &bleveIndex{index: index}
+	if rebuild {
+		if err := b.rebuild(context.Background(), db); err != nil {
+			_ = index.Close()
+			return nil, err
+		}
+	}
+
+	return b, nil
+}
+
+// newMapping 返回博客索引的字段映射.
+// 标题和内容使用 CJK 分析器，中文按照二元组切分，英文按照单词切分.
+func newMapping() mapping.IndexMapping {
+	text := bleve.NewTextFieldMapping()
+	text.Analyzer = cjk.AnalyzerName
+
+	userID := bleve.NewTextFieldMapping()
+	userID.Analyzer = keyword.Name
+	userID.Store = false
+	userID.IncludeTermVectors = false
+
+	doc := bleve.NewDocumentStaticMapping()
+	doc.AddFieldMappingsAt(FieldTitle, text)
+	doc.AddFieldMappingsAt(FieldContent, text)
+	doc.AddFieldMappingsAt(fieldUserID, userID)
+
+	m := bleve.NewIndexMapping()
+	m.DefaultMapping = doc
+	m.DefaultAnalyzer = cjk.AnalyzerName
+	return m
+}
+
+// document 返回博客在索引中的文档.
+func document(post *model.Post) map[string]any {
+	return map[string]any{
+		FieldTitle:   post.Title,
+		FieldContent: post.Content,
+		fieldUserID:  post.UserID,
+	}
+}
+
+// rebuild 将数据库中所有未删除的博客写入索引.
+func (b *bleveIndex) rebuild(ctx context.Context, db *gorm.DB) error {
+	var (
+		posts []*model.Post
+		total int
+	)
+	err := db.WithContext(ctx).FindInBatches(&posts, rebuildBatchSize, func(tx *gorm.DB, batch int) error {
+		total += len(posts)
+		return b.Index(ctx, posts...)
+	}).Error
+	if err != nil {
+		return err
+	}
+
+	slog.InfoContext(ctx, "Rebuilt post search index", "posts", total)
+	return nil
+}
+
+// Index 将博客写入索引.
+func (b *bleveIndex) Index(ctx context.Context, posts ...*model.Post) error {
+	batch := b.index.NewBatch()
+	for _, post := range posts {
+		if err := batch.Index(post.PostID, document(post)); err != nil {
+			return err
+		}
+	}
+
+	return b.index.Batch(batch)
+}
+
+// Delete 从索引中删除博客.
+func (b *bleveIndex) Delete(ctx context.Context, postIDs ...string) error {
+	batch := b.index.NewBatch()
+	for _, postID := range postIDs {
+		batch.Delete(postID)
+	}
+
+	return b.index.Batch(batch)
+}
+
+// Search 在标题和内容中检索关键词，标题匹配的权重更高.
+func (b *bleveIndex) Search(ctx context.Context, rq *Request) (*Result, error) {
+	title := bleve.NewMatchQuery(rq.Query)
+	title.SetField(FieldTitle)
+	title.SetBoost(titleBoost)
+	content := bleve.NewMatchQuery(rq.Query)
+	content.SetField(FieldContent)
+
+	var q query.Query = bleve.NewDisjunctionQuery(title, content)
+	if rq.UserID != "" {
+		owner := bleve.NewTermQuery(rq.UserID)
+		owner.SetField(fieldUserID)
+		q = bleve.NewConjunctionQuery(q, owner)
+	}
+
+	sr := bleve.NewSearchRequestOptions(q, rq.Limit, rq.Offset, false)
+	sr.Highlight = bleve.NewHighlightWithStyle(html.Name)
+	sr.Highlight.AddField(FieldTitle)
+	sr.Highlight.AddField(FieldContent)
+
+	res, err := b.index.SearchInContext(ctx, sr)
+	if err != nil {
+		return nil, err
+	}
+
+	ret := &Result{Total: int64(res.Total), Hits: make([]*Hit, 0, len(res.Hits))}
+	for _, hit := range res.Hits {
+		ret.Hits = append(ret.Hits, &Hit{
+			PostID:     hit.ID,
+			Score:      hit.Score,
+			Highlights: hit.Fragments,
+		})
+	}
+
+	return ret, nil
+}
+
+// Close 关闭索引.
+func (b *bleveIndex) Close() error {
+	return b.index.Close()
+}
@@ -0,0 +1,90 @@
+package search
+
+import (
+	"html"
+	"strings"
+	"unicode"
+)
+
+// fragmentSize 是内容高亮片段的最大字符数，与 Bleve 默认的片段长度一致.
+const fragmentSize = 200
+
+// queryTerms 将检索关键词按空白字符切分并转换为小写.
+func queryTerms(q string) [][]rune {
+	var terms [][]rune
+	for _, term := range strings.Fields(q) {
+		terms = append(terms, []rune(strings.ToLower(term)))
+	}
+	return terms
+}
+
+// highlight 截取 text 中第一个关键词附近最多 size 个字符（size 为 0 时不截取），
+// 用 <mark> 标记片段中的所有关键词，并对其余内容做 HTML 转义. 没有匹配的关键词时截取 text 的开头.
+func highlight(text string, terms [][]rune, size int) string {
+	orig := []rune(text)
+	lower := make([]rune, len(orig))
+	for i, r := range orig {
+		lower[i] = unicode.ToLower(r)
+	}
+
+	// 从左到右查找关键词，同一位置优先匹配最长的关键词
+	type span struct{ start, end int }
+	var spans []span
+	for i := 0; i < len(lower); {
+		n := 0
+		for _, term := range terms {
+			if len(term) > n && hasPrefix(lower[i:], term) {
+				n = len(term)
+			}
+		}
+		if n == 0 {
+			i++
+			continue
+		}
+		spans = append(spans, span{i, i + n})
+		i += n
+	}
+
+	start, end := 0, len(orig)
+	if size > 0 && len(orig) > size {
+		end = size
+		if len(spans) > 0 {
+			// 第一个关键词之前保留四分之一的上下文
+			start = max(0, spans[0].start-size/4)
+			end = min(len(orig), start+size)
+			start = max(0, end-size)
+		}
+	}
+
+	var b strings.Builder
+	curr := start
+	for _, s := range spans {
+		if s.start < start {
+			continue
+		}
+		if s.end > end {
+			break
+		}
+		b.WriteString(html.EscapeString(string(orig[curr:s.start])))
+		b.WriteString("<mark>")
+		b.WriteString(html.EscapeString(string(orig[s.start:s.end])))
+		b.WriteString("</mark>")
+		curr = s.end
+	}
+	b.WriteString(html.EscapeString(string(orig[curr:end])))
+
+	return b.String()
+}
+
+// hasPrefix 判断 s 是否以 prefix 开头.
+func hasPrefix(s, prefix []rune) bool {
+	if len(s) < len(prefix) {
+		return false
+	}
+	for i := range prefix {
+		if s[i] != prefix[i] {
+			return false
+		}
+	}
+	return true
+}
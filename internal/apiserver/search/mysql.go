@@ -0,0 +1,81 @@
+package search
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/onexstack/fastgo/internal/apiserver/model"
+)
+
+// matchExpr 是 post 表 FULLTEXT 索引的检索表达式，列的顺序需要与索引定义一致.
+const matchExpr = "MATCH(title, content) AGAINST(? IN NATURAL LANGUAGE MODE)"
+
+// mysqlIndex 是基于 MySQL FULLTEXT 索引的 Index 实现.
+// 索引由 MySQL 在写入 post 表时自动维护，Index 和 Delete 不需要做任何事情.
+type mysqlIndex struct {
+	db *gorm.DB
+}
+
+// 确保 mysqlIndex 实现了 Index 接口.
+var _ Index = (*mysqlIndex)(nil)
+
+// newMySQLIndex 创建 mysqlIndex 的实例.
+func newMySQLIndex(db *gorm.DB) *mysqlIndex {
+	return &mysqlIndex{db: db}
+}
+
+// Index 由 MySQL 自动维护索引，直接返回.
+func (m *mysqlIndex) Index(ctx context.Context, posts ...*model.Post) error {
+	return nil
+}
+
+// Delete 由 MySQL 自动维护索引，直接返回.
+func (m *mysqlIndex) Delete(ctx context.Context, postIDs ...string) error {
+	return nil
+}
+
+// Search 使用自然语言模式检索标题和内容，并根据关键词截取高亮片段.
+func (m *mysqlIndex) Search(ctx context.Context, rq *Request) (*Result, error) {
+	db := m.db.WithContext(ctx).Model(new(model.Post)).Where(matchExpr, rq.Query)
+	if rq.UserID != "" {
+		db = db.Where("userID = ?", rq.UserID)
+	}
+
+	var total int64
+	if err := db.Session(&gorm.Session{}).Count(&total).Error; err != nil {
+		return nil, err
+	}
+
+	var rows []struct {
+		PostID  string  `gorm:"column:postID"`
+		Title   string  `gorm:"column:title"`
+		Content string  `gorm:"column:content"`
+		Score   float64 `gorm:"column:score"`
+	}
+	err := db.Select("postID, title, content, "+matchExpr+" AS score", rq.Query).
+		Order("score DESC, id DESC").Offset(rq.Offset).Limit(rq.Limit).Scan(&rows).Error
+	if err != nil {
+		return nil, err
+	}
+
+	terms := queryTerms(rq.Query)
+	ret := &Result{Total: total, Hits: make([]*Hit, 0, len(rows))}
+	for _, row := range rows {
+		ret.Hits = append(ret.Hits, &Hit{
+			PostID: row.PostID,
+			Score:  row.Score,
+			Highlights: map[string][]string{
+				FieldTitle:   {highlight(row.Title, terms, 0)},
+				FieldContent: {highlight(row.Content, terms, fragmentSize)},
+			},
+		})
+	}
+
+	return ret, nil
+}
+
+// Close 不持有额外的资源，直接返回.
+func (m *mysqlIndex) Close() error {
+	return nil
+}
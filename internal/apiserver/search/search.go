@@ -0,0 +1,81 @@
+// Package search 实现了博客的全文检索.
+//
+// 检索功能通过 Index 接口抽象，提供两种实现：
+//
+//   - bleve：内嵌的 Bleve 倒排索引，不依赖外部服务，适合本地开发和测试. 索引是数据库的派生数据，
+//     由 biz 层在博客创建、更新和删除后同步更新.
+//   - mysql：直接查询 post 表上的 FULLTEXT 索引，索引由 MySQL 自动维护，适合生产环境.
+package search // import "github.com/onexstack/fastgo/internal/apiserver/search"
+
+import (
+	"context"
+	"fmt"
+
+	"gorm.io/gorm"
+
+	"github.com/onexstack/fastgo/internal/apiserver/model"
+	genericoptions "github.com/onexstack/fastgo/pkg/options"
+)
+
+const (
+	// FieldTitle 是博客标题在检索结果高亮片段中的字段名.
+	FieldTitle = "title"
+	// FieldContent 是博客内容在检索结果高亮片段中的字段名.
+	FieldContent = "content"
+)
+
+// Request 表示一次全文检索请求.
+type Request struct {
+	// Query 是检索关键词.
+	Query string
+	// UserID 不为空时只检索该用户的博客.
+	UserID string
+	// Offset 是跳过的结果数量.
+	Offset int
+	// Limit 是返回的结果数量.
+	Limit int
+}
+
+// Hit 表示一条检索结果.
+type Hit struct {
+	// PostID 是匹配的博客 ID.
+	PostID string
+	// Score 是相关度评分，评分越高越相关. 不同实现的评分不可相互比较.
+	Score float64
+	// Highlights 是各字段中匹配关键词的片段，关键词使用 <mark> 标记，其余内容已做 HTML 转义.
+	// 字段中没有匹配的关键词时为字段开头的片段.
+	Highlights map[string][]string
+}
+
+// Result 表示全文检索的结果.
+type Result struct {
+	// Total 是匹配的博客总数.
+	Total int64
+	// Hits 是按相关度倒序排列的当前页结果.
+	Hits []*Hit
+}
+
+// Index 定义了博客全文检索需要实现的方法.
+type Index interface {
+	// Index 将博客写入索引，已存在的博客会被覆盖.
+	Index(ctx context.Context, posts ...*model.Post) error
+	// Delete 从索引中删除博客.
+	Delete(ctx context.Context, postIDs ...string) error
+	// Search 按相关度检索博客.
+	Search(ctx context.Context, rq *Request) (*Result, error)
+	// Close 释放索引占用的资源.
+	Close() error
+}
+
+// New 根据配置创建全文检索实现.
+func New(opts *genericoptions.SearchOptions, db *gorm.DB) (Index, error) {
+	if opts.Driver == genericoptions.SearchDriverMySQL {
+		// MySQL 全文检索依赖 post 表上的 FULLTEXT 索引，SQLite 没有该索引
+		if name := db.Dialector.Name(); name != genericoptions.DriverMySQL {
+			return nil, fmt.Errorf("search driver %s requires a mysql database, got %s", opts.Driver, name)
+		}
+		return newMySQLIndex(db), nil
+	}
+
+	return newBleveIndex(opts.IndexPath, db)
+}
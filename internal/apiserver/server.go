@@ -2,7 +2,9 @@ package apiserver
 
 import (
 	"context"
+	"errors"
 	"log/slog"
+	"net"
 	"net/http"
 	"os"
 	"os/signal"
@@ -10,31 +12,58 @@ import (
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"go.opentelemetry.io/otel"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"google.golang.org/grpc"
+
 	"github.com/onexstack/fastgo/internal/apiserver/biz"
 	"github.com/onexstack/fastgo/internal/apiserver/handler"
+	"github.com/onexstack/fastgo/internal/apiserver/pkg/authz"
 	"github.com/onexstack/fastgo/internal/apiserver/pkg/conversion/validation"
+	"github.com/onexstack/fastgo/internal/apiserver/search"
 	"github.com/onexstack/fastgo/internal/apiserver/store"
 	"github.com/onexstack/fastgo/internal/pkg/core"
 	"github.com/onexstack/fastgo/internal/pkg/errorsx"
+	"github.com/onexstack/fastgo/internal/pkg/known"
+	"github.com/onexstack/fastgo/internal/pkg/metrics"
 	mw "github.com/onexstack/fastgo/internal/pkg/middleware"
+	"github.com/onexstack/fastgo/internal/pkg/pagination"
+	"github.com/onexstack/fastgo/internal/pkg/tracing"
 	genericoptions "github.com/onexstack/fastgo/pkg/options"
+	"github.com/onexstack/fastgo/pkg/token"
+	"github.com/onexstack/fastgo/pkg/version"
 )
 
+// metricsPath 是 Prometheus 指标的访问路径.
+const metricsPath = "/metrics"
+
 type Config struct {
-	MySQLOptions *genericoptions.MySQLOptions
-	Addr         string
+	Mode              string
+	DBOptions         *genericoptions.DBOptions
+	MySQLOptions      *genericoptions.MySQLOptions
+	SQLiteOptions     *genericoptions.SQLiteOptions
+	JWTOptions        *genericoptions.JWTOptions
+	UserOptions       *genericoptions.UserOptions
+	PostOptions       *genericoptions.PostOptions
+	TrashOptions      *genericoptions.TrashOptions
+	PaginationOptions *genericoptions.PaginationOptions
+	SearchOptions     *genericoptions.SearchOptions
+	GRPCOptions       *genericoptions.GRPCOptions
+	MetricsOptions    *genericoptions.MetricsOptions
+	TracingOptions    *genericoptions.TracingOptions
+	Addr              string
 }
 
 type Server struct {
-	cfg *Config
-	srv *http.Server
-}
-
-func LogMiddleware() gin.HandlerFunc {
-	return func(c *gin.Context) {
-		slog.Info("Request received", "method", c.Request.Method, "path", c.Request.URL.Path)
-		c.Next()
-	}
+	cfg     *Config
+	srv     *http.Server
+	grpcSrv *grpc.Server
+	// metricsSrv 只在单独配置了指标监听地址时存在
+	metricsSrv *http.Server
+	// tracerProvider 只在配置了 span 导出方式时存在
+	tracerProvider *sdktrace.TracerProvider
+	store          store.IStore
+	search         search.Index
 }
 
 func (cfg *Config) NewServer() (*Server, error) {
@@ -43,33 +72,116 @@ func (cfg *Config) NewServer() (*Server, error) {
 	// gin.Recovery() 中间件，用来捕获任何 panic，并恢复
 	mws := []gin.HandlerFunc{
 		gin.Recovery(),
+		// Tracing 放在前面，后续中间件和处理器中创建的 span 都会挂在请求的 span 下
+		mw.Tracing(),
 		mw.NoCache,
 		mw.Cors,
 		mw.RequestID(),
+		mw.AccessLog(),
+	}
+	// 指标中间件放在最外层，这样 panic 恢复后返回的 500 也会被记录
+	if cfg.MetricsOptions.Enabled {
+		mws = append([]gin.HandlerFunc{mw.Metrics()}, mws...)
 	}
 	engine.Use(mws...)
 
+	// 内置的默认密钥随源码公开，只允许在开发模式下使用
+	if cfg.JWTOptions.UsesDefaultKey() {
+		if cfg.Mode != known.ModeDevelopment {
+			return nil, errors.New("refusing to start with the built-in default JWT key, set jwt.key or jwt.signing-key-file, or set mode to development")
+		}
+		slog.Warn("Using the built-in default JWT key, do not use it in production")
+	}
+
+	// 初始化 token 包并加载 JWT 签发和校验密钥
+	if err := cfg.JWTOptions.Init(); err != nil {
+		return nil, err
+	}
+
+	// 部署多个实例时，所有实例需要使用相同的分页令牌签名密钥
+	if cfg.PaginationOptions.TokenKey == "" && cfg.Mode != known.ModeDevelopment {
+		slog.Warn("pagination.token-key is not set, page tokens will not work across restarts or instances")
+	}
+	pagination.Init(cfg.PaginationOptions.TokenKey)
+
+	// 配置了导出方式时使用 SDK 创建 span，否则使用全局的空实现，只透传 traceparent
+	var tp *sdktrace.TracerProvider
+	if cfg.TracingOptions.Enabled() {
+		var err error
+		if tp, err = cfg.TracingOptions.NewTracerProvider(context.Background(), version.Get().GitVersion); err != nil {
+			return nil, err
+		}
+		otel.SetTracerProvider(tp)
+		slog.Info("Exporting traces", "exporter", cfg.TracingOptions.Exporter)
+	}
+
 	// 初始化数据库连接
-	db, err := cfg.MySQLOptions.NewDB()
+	db, err := cfg.NewDB()
 	if err != nil {
 		return nil, err
 	}
+	if err := cfg.ensureSchema(db); err != nil {
+		return nil, err
+	}
+	if cfg.MetricsOptions.Enabled {
+		if err := metrics.InstrumentDB(db); err != nil {
+			return nil, err
+		}
+	}
+	if err := tracing.InstrumentDB(db); err != nil {
+		return nil, err
+	}
 	store := store.NewStore(db)
-	cfg.InstallRESTAPI(engine, store)
+	if err := cfg.ensureAdmin(context.Background(), store); err != nil {
+		return nil, err
+	}
+	// 使用数据库中的吊销列表校验 token，退出登录后 token 立即失效
+	token.SetDenylist(store.RevokedToken())
+
+	// 初始化博客全文检索，内嵌索引为空时会根据数据库中的博客重建
+	index, err := search.New(cfg.SearchOptions, db)
+	if err != nil {
+		return nil, err
+	}
+	cfg.InstallRESTAPI(engine, store, index)
+	// 新增的路由必须同时补充 OpenAPI 文档，否则拒绝启动
+	if err := verifyOpenAPI(engine); err != nil {
+		return nil, err
+	}
 
 	srv := &http.Server{
 		Addr:    cfg.Addr,
 		Handler: engine,
 	}
 
+	// /metrics 不属于业务 API，不注册到 gin 中，也就不会出现在 OpenAPI 文档和请求指标中
+	var metricsSrv *http.Server
+	if cfg.MetricsOptions.Enabled {
+		if cfg.MetricsOptions.Addr == "" {
+			mux := http.NewServeMux()
+			mux.Handle(metricsPath, metrics.Handler())
+			mux.Handle("/", engine)
+			srv.Handler = mux
+		} else {
+			mux := http.NewServeMux()
+			mux.Handle(metricsPath, metrics.Handler())
+			metricsSrv = &http.Server{Addr: cfg.MetricsOptions.Addr, Handler: mux}
+		}
+	}
+
 	return &Server{
-		cfg: cfg,
-		srv: srv,
+		cfg:            cfg,
+		srv:            srv,
+		grpcSrv:        cfg.NewGRPCServer(store, index),
+		metricsSrv:     metricsSrv,
+		tracerProvider: tp,
+		store:          store,
+		search:         index,
 	}, nil
 }
 
 // 注册 API 路由。路由的路径和 HTTP 方法，严格遵循 REST 规范.
-func (cfg *Config) InstallRESTAPI(engine *gin.Engine, store store.IStore) {
+func (cfg *Config) InstallRESTAPI(engine *gin.Engine, store store.IStore, index search.Index) {
 	// 注册 404 Handler.
 	engine.NoRoute(func(c *gin.Context) {
 		core.WriteResponse(c, nil, errorsx.ErrNotFound.WithMessage("Page not found"))
@@ -80,13 +192,25 @@ func (cfg *Config) InstallRESTAPI(engine *gin.Engine, store store.IStore) {
 		core.WriteResponse(c, map[string]string{"status": "ok"}, nil)
 	})
 
+	// 注册 JWKS handler，其他服务可以使用这里公开的公钥校验 token.
+	engine.GET("/.well-known/jwks.json", func(c *gin.Context) {
+		core.WriteResponse(c, token.JWKS(), nil)
+	})
+
+	// 注册 OpenAPI 文档（/openapi.json）和 Swagger UI（/docs）
+	installOpenAPI(engine)
+
 	// 创建核心业务处理器
-	handler := handler.NewHandler(biz.NewBiz(store), validation.NewValidator(store))
-	authMiddlewares := []gin.HandlerFunc{mw.Authn()}
+	handler := handler.NewHandler(biz.NewBiz(store, index, cfg.UserOptions, cfg.PostOptions), validation.NewValidator(store))
+	// 先认证再授权：Authn 解析出用户 ID，Authz 根据用户角色和授权策略判断是否可以访问当前路由
+	authMiddlewares := []gin.HandlerFunc{mw.Authn(), mw.Authz(authz.New(store))}
 
-	// 注册用户登录和令牌刷新接口。这2个接口比较简单，所以没有 API 版本
+	// 注册用户登录、令牌刷新和退出登录接口。这几个接口比较简单，所以没有 API 版本
 	engine.POST("/login", handler.Login)
-	engine.POST("/refresh-token", mw.Authn(), handler.RefreshToken)
+	// 刷新令牌本身就是凭证，access token 过期后也需要能够刷新，所以不经过 Authn
+	engine.POST("/refresh-token", handler.RefreshToken)
+	engine.POST("/logout", mw.Authn(), handler.Logout)
+	engine.POST("/logout-all", mw.Authn(), handler.LogoutAll)
 
 	// 注册 v1 版本 API 路由分组
 	v1 := engine.Group("/v1")
@@ -99,26 +223,107 @@ func (cfg *Config) InstallRESTAPI(engine *gin.Engine, store store.IStore) {
 			userv1.Use(authMiddlewares...)
 
 			userv1.PUT(":userID", handler.UpdateUser)    // 更新用户信息
-			userv1.DELETE(":userID", handler.DeleteUser) // 删除用户
+			userv1.DELETE(":userID", handler.DeleteUser) // 申请删除账号，宽限期结束后删除账号及其所有数据
 			userv1.GET(":userID", handler.GetUser)       // 查询用户详情
 			userv1.GET("", handler.ListUser)             // 查询用户列表.
 			userv1.PUT(":userID/change-password", handler.ChangePassword)
+			userv1.PUT(":userID/role", handler.UpdateUserRole)                 // 修改用户角色，默认仅管理员可访问
+			userv1.GET("trash", handler.ListTrashUser)                         // 查询回收站中的用户，仅管理员可访问
+			userv1.POST(":userID/restore", handler.RestoreUser)                // 从回收站恢复用户，仅管理员可访问
+			userv1.POST(":userID/cancel-deletion", handler.CancelUserDeletion) // 在宽限期内取消删除账号
+			userv1.GET(":userID/export", handler.ExportUser)                   // 导出用户的所有个人数据，支持 JSON 和 ZIP 格式
 		}
 
 		// 博客相关路由
 		postv1 := v1.Group("/posts", authMiddlewares...)
 		{
-			postv1.POST("", handler.CreatePost)       // 创建博客
-			postv1.PUT(":postID", handler.UpdatePost) // 更新博客
-			postv1.DELETE("", handler.DeletePost)     // 删除博客
-			postv1.GET(":postID", handler.GetPost)    // 查询博客详情
-			postv1.GET("", handler.ListPost)          // 查询博客列表
+			postv1.POST("", handler.CreatePost)                     // 创建博客
+			postv1.PUT(":postID", handler.UpdatePost)               // 更新博客
+			postv1.DELETE("", handler.DeletePost)                   // 删除博客，删除的博客会移入回收站
+			postv1.GET("trash", handler.ListTrashPost)              // 查询回收站中的博客
+			postv1.GET("search", handler.SearchPost)                // 全文检索博客，按照相关度排列
+			postv1.POST(":postID/restore", handler.RestorePost)     // 从回收站恢复博客
+			postv1.GET(":postID", handler.GetPost)                  // 查询博客详情
+			postv1.GET("", handler.ListPost)                        // 查询博客列表
+			postv1.POST(":postID/publish", handler.PublishPost)     // 发布或定时发布博客
+			postv1.POST(":postID/unpublish", handler.UnpublishPost) // 撤回或归档博客
+
+			// 评论相关路由，博客作者可以删除自己博客下的任意评论
+			postv1.POST(":postID/comments", handler.CreateComment)
+			postv1.PUT(":postID/comments/:commentID", handler.UpdateComment)
+			postv1.DELETE(":postID/comments/:commentID", handler.DeleteComment)
+			postv1.GET(":postID/comments", handler.ListComment)
+
+			// 修订历史相关路由，每次修改标题或内容都会生成一个新的修订版本
+			postv1.GET(":postID/revisions", handler.ListPostRevision)
+			postv1.GET(":postID/revisions/diff", handler.DiffPostRevision) // 比较两个修订版本
+			postv1.GET(":postID/revisions/:revision", handler.GetPostRevision)
+			postv1.POST(":postID/revisions/:revision/restore", handler.RestorePostRevision) // 将旧版本恢复为新版本
+		}
+
+		// 标签相关路由，普通用户只能查询，重命名和合并默认仅管理员可访问
+		tagv1 := v1.Group("/tags", authMiddlewares...)
+		{
+			tagv1.GET("", handler.ListTag)        // 查询标签列表及博客数量
+			tagv1.PUT(":name", handler.RenameTag) // 重命名标签
+			tagv1.POST("merge", handler.MergeTag) // 合并标签
+		}
+
+		// 分类相关路由，普通用户只能查询，创建和删除默认仅管理员可访问
+		categoryv1 := v1.Group("/categories", authMiddlewares...)
+		{
+			categoryv1.POST("", handler.CreateCategory)              // 创建分类
+			categoryv1.DELETE(":categoryID", handler.DeleteCategory) // 删除分类
+			categoryv1.GET("", handler.ListCategory)                 // 查询分类列表
+		}
+
+		// 公开的只读路由，无需认证，只返回已发布的公开博客
+		publicv1 := v1.Group("/public")
+		{
+			publicv1.GET("/posts", handler.ListPublicPost)                 // 查询公开博客列表
+			publicv1.GET("/posts/:postID", handler.GetPublicPost)          // 查询公开博客详情
+			publicv1.GET("/users/:username/posts", handler.ListPublicPost) // 查询指定作者的公开博客列表
+		}
+
+		// 授权策略相关路由，默认仅管理员可访问
+		policyv1 := v1.Group("/policies", authMiddlewares...)
+		{
+			policyv1.POST("", handler.CreatePolicy)   // 创建授权策略
+			policyv1.DELETE("", handler.DeletePolicy) // 删除授权策略
+			policyv1.GET("", handler.ListPolicy)      // 查询授权策略列表
+		}
+
+		// 运维管理相关路由，默认仅管理员可访问
+		adminv1 := v1.Group("/admin", authMiddlewares...)
+		{
+			adminv1.GET("log-level", handler.GetLogLevel)    // 查询当前实例的日志级别
+			adminv1.PUT("log-level", handler.UpdateLogLevel) // 运行时修改当前实例的日志级别
 		}
 	}
 }
 
 func (s *Server) Run() error {
-	slog.Info("Read MySQL host from config", "mysql.addr", s.cfg.MySQLOptions.Addr)
+	switch s.cfg.DBOptions.Driver {
+	case genericoptions.DriverMySQL:
+		slog.Info("Read MySQL host from config", "mysql.addr", s.cfg.MySQLOptions.Addr)
+	case genericoptions.DriverSQLite:
+		slog.Info("Read SQLite database from config", "sqlite.database", s.cfg.SQLiteOptions.Database)
+	default:
+		slog.Info("Using in-memory database, all data will be lost on exit")
+	}
+
+	// gRPC 服务监听单独的端口，端口被占用时直接返回错误
+	lis, err := net.Listen("tcp", s.cfg.GRPCOptions.Addr)
+	if err != nil {
+		return err
+	}
+
+	// 启动定时发布博客和清理回收站的后台任务，服务关闭时停止
+	backgroundCtx, stopBackground := context.WithCancel(context.Background())
+	defer stopBackground()
+	b := biz.NewBiz(s.store, s.search, s.cfg.UserOptions, s.cfg.PostOptions)
+	go runPostPublisher(backgroundCtx, b.PostV1())
+	go runTrashPurger(backgroundCtx, b, s.cfg.TrashOptions)
 
 	go func() {
 		if err := s.srv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
@@ -126,6 +331,22 @@ func (s *Server) Run() error {
 		}
 	}()
 
+	if s.metricsSrv != nil {
+		slog.Info("Starting metrics server", "addr", s.metricsSrv.Addr)
+		go func() {
+			if err := s.metricsSrv.ListenAndServe(); err != nil && err != http.ErrServerClosed {
+				slog.Error("Failed to start metrics server", "error", err)
+			}
+		}()
+	}
+
+	slog.Info("Starting grpc server", "addr", s.cfg.GRPCOptions.Addr)
+	go func() {
+		if err := s.grpcSrv.Serve(lis); err != nil {
+			slog.Error("Failed to start grpc server", "error", err)
+		}
+	}()
+
 	// 创建一个 os.Signal 类型的 channel，用于接收系统信号
 	quit := make(chan os.Signal, 1)
 
@@ -135,6 +356,7 @@ func (s *Server) Run() error {
 	<-quit
 
 	slog.Info("Shutting down server...")
+	stopBackground()
 
 	// 优雅关闭服务
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
@@ -145,6 +367,37 @@ func (s *Server) Run() error {
 		return err
 	}
 
+	if s.metricsSrv != nil {
+		if err := s.metricsSrv.Shutdown(ctx); err != nil {
+			slog.Error("Failed to shutdown metrics server", "error", err)
+		}
+	}
+
+	// 等待进行中的 gRPC 请求处理完成，超时后强制关闭
+	stopped := make(chan struct{})
+	go func() {
+		s.grpcSrv.GracefulStop()
+		close(stopped)
+	}()
+	select {
+	case <-stopped:
+	case <-ctx.Done():
+		s.grpcSrv.Stop()
+	}
+
+	if err := s.search.Close(); err != nil {
+		slog.Error("Failed to close search index", "error", err)
+	}
+
+	// 导出尚未发送的 span. OTLP 接收端不可用时导出会一直重试，所以单独设置较短的超时时间
+	if s.tracerProvider != nil {
+		tpCtx, tpCancel := context.WithTimeout(ctx, 3*time.Second)
+		defer tpCancel()
+		if err := s.tracerProvider.Shutdown(tpCtx); err != nil {
+			slog.Error("Failed to shutdown tracer provider", "error", err)
+		}
+	}
+
 	slog.Info("Server exited")
 
 	return nil
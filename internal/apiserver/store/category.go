@@ -0,0 +1,99 @@
+// nolint: dupl
+package store
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/onexstack/fastgo/internal/apiserver/model"
+	"github.com/onexstack/fastgo/internal/pkg/errorsx"
+)
+
+// CategoryStore 定义了 category 模块在 store 层所实现的方法.
+type CategoryStore interface {
+	Create(ctx context.Context, obj *model.Category) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.Category, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.Category, error)
+
+	CategoryExpansion
+}
+
+// CategoryExpansion 定义了分类操作的附加方法.
+type CategoryExpansion interface {
+	// Page 按照指定的排序字段对分类进行游标分页查询.
+	Page(ctx context.Context, opts *where.Options, page *PageOptions) (*Page[model.Category], error)
+}
+
+// categoryStore 是 CategoryStore 接口的实现.
+type categoryStore struct {
+	store *datastore
+}
+
+// 确保 categoryStore 实现了 CategoryStore 接口.
+var _ CategoryStore = (*categoryStore)(nil)
+
+// newCategoryStore 创建 categoryStore 的实例.
+func newCategoryStore(store *datastore) *categoryStore {
+	return &categoryStore{store}
+}
+
+// Create 插入一条分类记录.
+func (s *categoryStore) Create(ctx context.Context, obj *model.Category) error {
+	if err := s.store.DB(ctx).Create(&obj).Error; err != nil {
+		slog.ErrorContext(ctx, "Failed to insert category into database", "err", err, "category", obj)
+		return errorsx.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Delete 根据条件删除分类记录.
+func (s *categoryStore) Delete(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Delete(new(model.Category)).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		slog.ErrorContext(ctx, "Failed to delete category from database", "err", err, "conditions", opts)
+		return errorsx.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Get 根据条件查询分类记录.
+func (s *categoryStore) Get(ctx context.Context, opts *where.Options) (*model.Category, error) {
+	var obj model.Category
+	if err := s.store.DB(ctx, opts).First(&obj).Error; err != nil {
+		slog.ErrorContext(ctx, "Failed to retrieve category from database", "err", err, "conditions", opts)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorsx.ErrCategoryNotFound
+		}
+		return nil, errorsx.ErrDBRead.WithMessage("%s", err.Error())
+	}
+
+	return &obj, nil
+}
+
+// List 返回分类列表和总数，按照分类路径排序，子分类紧跟在父分类之后.
+// nolint: nonamedreturns
+func (s *categoryStore) List(ctx context.Context, opts *where.Options) (count int64, ret []*model.Category, err error) {
+	err = s.store.DB(ctx, opts).Order("path").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list categories from database", "err", err, "conditions", opts)
+		err = errorsx.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	return
+}
+
+// Page 对分类进行游标分页查询.
+func (s *categoryStore) Page(ctx context.Context, opts *where.Options, page *PageOptions) (*Page[model.Category], error) {
+	ret, err := listPage[model.Category](ctx, s.store.DB(ctx, opts), page)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to page categories from database", "err", err, "conditions", opts)
+		return nil, errorsx.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	return ret, nil
+}
@@ -0,0 +1,129 @@
+// nolint: dupl
+package store
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/onexstack/fastgo/internal/apiserver/model"
+	"github.com/onexstack/fastgo/internal/pkg/errorsx"
+)
+
+// CommentStore 定义了 comment 模块在 store 层所实现的方法.
+type CommentStore interface {
+	Create(ctx context.Context, obj *model.Comment) error
+	Update(ctx context.Context, obj *model.Comment) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.Comment, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.Comment, error)
+
+	CommentExpansion
+}
+
+// CommentExpansion 定义了评论操作的附加方法.
+type CommentExpansion interface {
+	// Page 按照指定的排序字段对评论进行游标分页查询.
+	Page(ctx context.Context, opts *where.Options, page *PageOptions) (*Page[model.Comment], error)
+	// Anonymize 将符合条件的评论标记为已删除并清空评论者和评论内容，评论在评论树中的位置保持不变.
+	Anonymize(ctx context.Context, opts *where.Options) error
+}
+
+// commentStore 是 CommentStore 接口的实现.
+type commentStore struct {
+	store *datastore
+}
+
+// 确保 commentStore 实现了 CommentStore 接口.
+var _ CommentStore = (*commentStore)(nil)
+
+// newCommentStore 创建 commentStore 的实例.
+func newCommentStore(store *datastore) *commentStore {
+	return &commentStore{store}
+}
+
+// Create 插入一条评论记录.
+func (s *commentStore) Create(ctx context.Context, obj *model.Comment) error {
+	if err := s.store.DB(ctx).Create(&obj).Error; err != nil {
+		slog.ErrorContext(ctx, "Failed to insert comment into database", "err", err, "comment", obj)
+		return errorsx.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Update 更新评论数据库记录.
+func (s *commentStore) Update(ctx context.Context, obj *model.Comment) error {
+	if err := s.store.DB(ctx).Save(obj).Error; err != nil {
+		slog.ErrorContext(ctx, "Failed to update comment in database", "err", err, "comment", obj)
+		return errorsx.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Delete 根据条件删除评论记录.
+func (s *commentStore) Delete(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Delete(new(model.Comment)).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		slog.ErrorContext(ctx, "Failed to delete comment from database", "err", err, "conditions", opts)
+		return errorsx.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Get 根据条件查询评论记录.
+func (s *commentStore) Get(ctx context.Context, opts *where.Options) (*model.Comment, error) {
+	var obj model.Comment
+	if err := s.store.DB(ctx, opts).First(&obj).Error; err != nil {
+		slog.ErrorContext(ctx, "Failed to retrieve comment from database", "err", err, "conditions", opts)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorsx.ErrCommentNotFound
+		}
+		return nil, errorsx.ErrDBRead.WithMessage("%s", err.Error())
+	}
+
+	return &obj, nil
+}
+
+// List 返回评论列表和总数，按照创建顺序排列.
+// nolint: nonamedreturns
+func (s *commentStore) List(ctx context.Context, opts *where.Options) (count int64, ret []*model.Comment, err error) {
+	err = s.store.DB(ctx, opts).Order("id").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list comments from database", "err", err, "conditions", opts)
+		err = errorsx.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	return
+}
+
+// Anonymize 匿名化符合条件的评论，已删除的评论保留原来的删除时间.
+func (s *commentStore) Anonymize(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Model(new(model.Comment)).
+		Updates(map[string]any{
+			"userID":    "",
+			"content":   "",
+			"deletedAt": gorm.Expr("COALESCE(deletedAt, ?)", time.Now()),
+		}).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to anonymize comments", "err", err, "conditions", opts)
+		return errorsx.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Page 对评论进行游标分页查询.
+func (s *commentStore) Page(ctx context.Context, opts *where.Options, page *PageOptions) (*Page[model.Comment], error) {
+	ret, err := listPage[model.Comment](ctx, s.store.DB(ctx, opts), page)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to page comments from database", "err", err, "conditions", opts)
+		return nil, errorsx.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	return ret, nil
+}
@@ -0,0 +1,104 @@
+package store
+
+import (
+	"context"
+	"encoding/json"
+	"reflect"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/onexstack/fastgo/internal/pkg/pagination"
+)
+
+// PageOptions 定义了游标（keyset）分页查询的参数.
+type PageOptions struct {
+	// SortBy 是排序字段对应的数据库列名，调用方需要保证该字段来自允许排序的字段列表且不为 NULL.
+	SortBy string
+	// Desc 表示是否倒序排列.
+	Desc bool
+	// After 是上一页最后一条记录的位置，为空时从第一页开始.
+	After *pagination.Token
+	// Limit 是每页数量.
+	Limit int
+	// WithTotal 表示是否统计符合条件的记录总数，统计总数需要额外执行一次 COUNT 查询.
+	WithTotal bool
+}
+
+// Page 表示一页查询结果.
+type Page[T any] struct {
+	// Items 是当前页的记录.
+	Items []*T
+	// Total 是符合条件的记录总数，只有 PageOptions.WithTotal 为 true 时才会统计.
+	Total *int64
+	// Next 是当前页最后一条记录的位置，没有下一页时为空.
+	Next *pagination.Token
+}
+
+// listPage 按照排序字段和 ID 对 db 中的查询进行 keyset 分页.
+// 排序字段的值相同时按照 ID 排序，保证顺序稳定. 下一页的查询条件基于上一页最后一条记录的位置，
+// 而不是偏移量，因此翻页时不需要扫描前面的记录，数据变化时也不会出现重复或遗漏.
+func listPage[T any](ctx context.Context, db *gorm.DB, page *PageOptions) (*Page[T], error) {
+	stmt := &gorm.Statement{DB: db}
+	if err := stmt.Parse(new(T)); err != nil {
+		return nil, err
+	}
+	field := stmt.Schema.LookUpField(page.SortBy)
+	if field == nil {
+		return nil, gorm.ErrInvalidField
+	}
+
+	// 计数和分页查询共用过滤条件，需要使用新的会话避免相互影响
+	db = db.Session(&gorm.Session{})
+
+	var ret Page[T]
+	if page.WithTotal {
+		var total int64
+		if err := db.Model(new(T)).Count(&total).Error; err != nil {
+			return nil, err
+		}
+		ret.Total = &total
+	}
+
+	column := clause.Column{Name: field.DBName}
+	id := clause.Column{Name: "id"}
+	op := ">"
+	if page.Desc {
+		op = "<"
+	}
+
+	query := db
+	if page.After != nil {
+		value := reflect.New(field.FieldType)
+		if err := json.Unmarshal(page.After.Value, value.Interface()); err != nil {
+			return nil, pagination.ErrInvalidToken
+		}
+		after := value.Elem().Interface()
+		query = query.Where("? "+op+" ? OR (? = ? AND ? "+op+" ?)", column, after, column, after, id, page.After.ID)
+	}
+
+	// 多查询一条，用来判断是否还有下一页
+	err := query.
+		Order(clause.OrderByColumn{Column: column, Desc: page.Desc}).
+		Order(clause.OrderByColumn{Column: id, Desc: page.Desc}).
+		Limit(page.Limit + 1).
+		Find(&ret.Items).Error
+	if err != nil {
+		return nil, err
+	}
+
+	if len(ret.Items) > page.Limit {
+		ret.Items = ret.Items[:page.Limit]
+
+		last := reflect.ValueOf(ret.Items[len(ret.Items)-1]).Elem()
+		value, _ := field.ValueOf(ctx, last)
+		data, err := json.Marshal(value)
+		if err != nil {
+			return nil, err
+		}
+		lastID, _ := stmt.Schema.PrioritizedPrimaryField.ValueOf(ctx, last)
+		ret.Next = &pagination.Token{SortBy: page.SortBy, Desc: page.Desc, Value: data, ID: lastID.(int64)}
+	}
+
+	return &ret, nil
+}
@@ -0,0 +1,169 @@
+package store
+
+import (
+	"context"
+	"slices"
+	"testing"
+
+	"gorm.io/gorm"
+
+	"github.com/onexstack/fastgo/internal/pkg/pagination"
+	"github.com/onexstack/fastgo/internal/pkg/testdb"
+)
+
+// pageItem 是分页测试使用的模型，多条记录的 Score 相同.
+type pageItem struct {
+	ID    int64 `gorm:"primaryKey"`
+	Score int64
+}
+
+// newPageTestDB 创建包含分页测试数据的 SQLite 内存数据库.
+func newPageTestDB(t *testing.T, scores []int64) *gorm.DB {
+	t.Helper()
+
+	db := testdb.New(t)
+
+	if err := db.AutoMigrate(&pageItem{}); err != nil {
+		t.Fatalf("failed to create table: %v", err)
+	}
+	for _, score := range scores {
+		if err := db.Create(&pageItem{Score: score}).Error; err != nil {
+			t.Fatalf("failed to insert item: %v", err)
+		}
+	}
+
+	return db
+}
+
+// readAll 逐页读取所有记录，下一页的位置经过令牌编码和解码，与接口的翻页流程一致.
+func readAll(t *testing.T, db *gorm.DB, desc bool, limit int) [][]int64 {
+	t.Helper()
+
+	var pages [][]int64
+	page := &PageOptions{SortBy: "Score", Desc: desc, Limit: limit}
+	for i := 0; ; i++ {
+		if i > 100 {
+			t.Fatal("pagination does not terminate")
+		}
+
+		ret, err := listPage[pageItem](context.Background(), db, page)
+		if err != nil {
+			t.Fatalf("listPage() error = %v", err)
+		}
+
+		var ids []int64
+		for _, item := range ret.Items {
+			ids = append(ids, item.ID)
+		}
+		pages = append(pages, ids)
+
+		if ret.Next == nil {
+			return pages
+		}
+
+		after, err := pagination.Decode(pagination.Encode(ret.Next))
+		if err != nil {
+			t.Fatalf("Decode() error = %v", err)
+		}
+		page.After = after
+	}
+}
+
+func TestListPageWithDuplicateSortValues(t *testing.T) {
+	// ID 从 1 开始，相同 Score 的记录分散插入，多页的边界落在相同 Score 的记录之间
+	scores := []int64{2, 1, 2, 3, 1, 2, 2, 1, 3, 2}
+	db := newPageTestDB(t, scores)
+
+	asc := []int64{2, 5, 8, 1, 3, 6, 7, 10, 4, 9}
+	desc := slices.Clone(asc)
+	slices.Reverse(desc)
+
+	for _, tt := range []struct {
+		name  string
+		desc  bool
+		limit int
+		want  []int64
+	}{
+		{name: "asc limit 1", limit: 1, want: asc},
+		{name: "asc limit 2", limit: 2, want: asc},
+		{name: "asc limit 3", limit: 3, want: asc},
+		{name: "asc limit 4", limit: 4, want: asc},
+		{name: "asc single page", limit: 10, want: asc},
+		{name: "desc limit 2", desc: true, limit: 2, want: desc},
+		{name: "desc limit 3", desc: true, limit: 3, want: desc},
+		{name: "desc single page", desc: true, limit: 20, want: desc},
+	} {
+		t.Run(tt.name, func(t *testing.T) {
+			pages := readAll(t, db, tt.desc, tt.limit)
+
+			var got []int64
+			for i, ids := range pages {
+				if len(ids) == 0 || len(ids) > tt.limit || i < len(pages)-1 && len(ids) != tt.limit {
+					t.Errorf("page %d has %d items, limit %d", i, len(ids), tt.limit)
+				}
+				got = append(got, ids...)
+			}
+
+			// 所有记录按照 (Score, ID) 的顺序出现且只出现一次
+			if !slices.Equal(got, tt.want) {
+				t.Errorf("pages = %v, want %v", pages, tt.want)
+			}
+		})
+	}
+}
+
+func TestListPageConcurrentInsert(t *testing.T) {
+	db := newPageTestDB(t, []int64{1, 1, 1, 1})
+
+	ret, err := listPage[pageItem](context.Background(), db, &PageOptions{SortBy: "Score", Limit: 2})
+	if err != nil {
+		t.Fatalf("listPage() error = %v", err)
+	}
+
+	// 翻页期间在已读取的位置之前插入记录，下一页不应出现重复的记录
+	if err := db.Create(&pageItem{Score: 0}).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	next, err := listPage[pageItem](context.Background(), db, &PageOptions{SortBy: "Score", Limit: 2, After: ret.Next})
+	if err != nil {
+		t.Fatalf("listPage() error = %v", err)
+	}
+
+	var ids []int64
+	for _, item := range next.Items {
+		ids = append(ids, item.ID)
+	}
+	if !slices.Equal(ids, []int64{3, 4}) {
+		t.Errorf("second page = %v, want [3 4]", ids)
+	}
+}
+
+func TestListPageTotal(t *testing.T) {
+	db := newPageTestDB(t, []int64{1, 2, 3})
+
+	ret, err := listPage[pageItem](context.Background(), db.Where("score > ?", 1), &PageOptions{SortBy: "Score", Limit: 1, WithTotal: true})
+	if err != nil {
+		t.Fatalf("listPage() error = %v", err)
+	}
+	if ret.Total == nil || *ret.Total != 2 {
+		t.Errorf("Total = %v, want 2", ret.Total)
+	}
+	if len(ret.Items) != 1 || ret.Items[0].ID != 2 {
+		t.Errorf("Items = %v, want the item with ID 2", ret.Items)
+	}
+}
+
+func TestListPageInvalidToken(t *testing.T) {
+	db := newPageTestDB(t, []int64{1})
+
+	// 令牌中的值与排序字段类型不一致
+	after := &pagination.Token{SortBy: "Score", Value: []byte(`"not a number"`), ID: 1}
+	if _, err := listPage[pageItem](context.Background(), db, &PageOptions{SortBy: "Score", Limit: 1, After: after}); err != pagination.ErrInvalidToken {
+		t.Errorf("listPage() error = %v, want ErrInvalidToken", err)
+	}
+
+	if _, err := listPage[pageItem](context.Background(), db, &PageOptions{SortBy: "Unknown", Limit: 1}); err != gorm.ErrInvalidField {
+		t.Errorf("listPage() with unknown sort field error = %v, want ErrInvalidField", err)
+	}
+}
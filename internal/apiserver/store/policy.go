@@ -0,0 +1,99 @@
+// nolint: dupl
+package store
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/onexstack/fastgo/internal/apiserver/model"
+	"github.com/onexstack/fastgo/internal/pkg/errorsx"
+)
+
+// PolicyStore 定义了 policy 模块在 store 层所实现的方法.
+type PolicyStore interface {
+	Create(ctx context.Context, obj *model.Policy) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.Policy, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.Policy, error)
+
+	PolicyExpansion
+}
+
+// PolicyExpansion 定义了授权策略操作的附加方法.
+type PolicyExpansion interface {
+	// Page 按照指定的排序字段对授权策略进行游标分页查询.
+	Page(ctx context.Context, opts *where.Options, page *PageOptions) (*Page[model.Policy], error)
+}
+
+// policyStore 是 PolicyStore 接口的实现.
+type policyStore struct {
+	store *datastore
+}
+
+// 确保 policyStore 实现了 PolicyStore 接口.
+var _ PolicyStore = (*policyStore)(nil)
+
+// newPolicyStore 创建 policyStore 的实例.
+func newPolicyStore(store *datastore) *policyStore {
+	return &policyStore{store}
+}
+
+// Create 插入一条授权策略记录.
+func (s *policyStore) Create(ctx context.Context, obj *model.Policy) error {
+	if err := s.store.DB(ctx).Create(&obj).Error; err != nil {
+		slog.ErrorContext(ctx, "Failed to insert policy into database", "err", err, "policy", obj)
+		return errorsx.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Delete 根据条件删除授权策略记录.
+func (s *policyStore) Delete(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Delete(new(model.Policy)).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		slog.ErrorContext(ctx, "Failed to delete policy from database", "err", err, "conditions", opts)
+		return errorsx.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Get 根据条件查询授权策略记录.
+func (s *policyStore) Get(ctx context.Context, opts *where.Options) (*model.Policy, error) {
+	var obj model.Policy
+	if err := s.store.DB(ctx, opts).First(&obj).Error; err != nil {
+		slog.ErrorContext(ctx, "Failed to retrieve policy from database", "err", err, "conditions", opts)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorsx.ErrPolicyNotFound
+		}
+		return nil, errorsx.ErrDBRead.WithMessage("%s", err.Error())
+	}
+
+	return &obj, nil
+}
+
+// List 返回授权策略列表和总数.
+// nolint: nonamedreturns
+func (s *policyStore) List(ctx context.Context, opts *where.Options) (count int64, ret []*model.Policy, err error) {
+	err = s.store.DB(ctx, opts).Order("id desc").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list policies from database", "err", err, "conditions", opts)
+		err = errorsx.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	return
+}
+
+// Page 对授权策略进行游标分页查询.
+func (s *policyStore) Page(ctx context.Context, opts *where.Options, page *PageOptions) (*Page[model.Policy], error) {
+	ret, err := listPage[model.Policy](ctx, s.store.DB(ctx, opts), page)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to page policies from database", "err", err, "conditions", opts)
+		return nil, errorsx.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	return ret, nil
+}
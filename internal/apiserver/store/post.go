@@ -11,12 +11,14 @@ import (
 	"context"
 	"errors"
 	"log/slog"
+	"time"
 
 	"github.com/onexstack/onexstack/pkg/store/where"
 	"gorm.io/gorm"
 
 	"github.com/onexstack/fastgo/internal/apiserver/model"
 	"github.com/onexstack/fastgo/internal/pkg/errorsx"
+	"github.com/onexstack/fastgo/internal/pkg/known"
 )
 
 // PostStore 定义了 post 模块在 store 层所实现的方法.
@@ -31,7 +33,20 @@ type PostStore interface {
 }
 
 // PostExpansion 定义了帖子操作的附加方法.
-type PostExpansion interface{}
+type PostExpansion interface {
+	// PublishDue 将定时发布时间早于等于 now 的博客改为已发布状态，返回发布的博客数量.
+	PublishDue(ctx context.Context, now time.Time) (int64, error)
+	// Page 按照指定的排序字段对博客进行游标分页查询.
+	Page(ctx context.Context, opts *where.Options, page *PageOptions) (*Page[model.Post], error)
+	// PageTrashed 按照指定的排序字段对回收站中的博客进行游标分页查询.
+	PageTrashed(ctx context.Context, opts *where.Options, page *PageOptions) (*Page[model.Post], error)
+	// ListTrashed 返回回收站中的博客列表和总数，按照删除时间倒序排列.
+	ListTrashed(ctx context.Context, opts *where.Options) (int64, []*model.Post, error)
+	// Restore 将回收站中符合条件的博客恢复，返回恢复的博客数量.
+	Restore(ctx context.Context, opts *where.Options) (int64, error)
+	// Purge 永久删除符合条件的博客，包括回收站中的博客.
+	Purge(ctx context.Context, opts *where.Options) error
+}
 
 // postStore 是 PostStore 接口的实现.
 type postStore struct {
@@ -49,29 +64,41 @@ func newPostStore(store *datastore) *postStore {
 // Create 插入一条帖子记录.
 func (s *postStore) Create(ctx context.Context, obj *model.Post) error {
 	if err := s.store.DB(ctx).Create(&obj).Error; err != nil {
-		slog.Error("Failed to insert post into database", "err", err, "post", obj)
-		return errorsx.ErrDBWrite.WithMessage(err.Error())
+		slog.ErrorContext(ctx, "Failed to insert post into database", "err", err, "post", obj)
+		return errorsx.ErrDBWrite.WithMessage("%s", err.Error())
 	}
 
 	return nil
 }
 
-// Update 更新帖子数据库记录.
+// Update 更新帖子数据库记录，并将版本号加 1.
+// 更新条件中包含读取时的版本号，记录在读取之后被其他请求修改时返回 ErrVersionConflict.
 func (s *postStore) Update(ctx context.Context, obj *model.Post) error {
-	if err := s.store.DB(ctx).Save(obj).Error; err != nil {
-		slog.Error("Failed to update post in database", "err", err, "post", obj)
-		return errorsx.ErrDBWrite.WithMessage(err.Error())
+	version := obj.Version
+	obj.Version++
+
+	// 指定 Select 后 Save 只执行 UPDATE，不会在没有匹配记录时改为插入
+	result := s.store.DB(ctx).Select("*").Where("version = ?", version).Save(obj)
+	if result.Error != nil {
+		obj.Version = version
+		slog.ErrorContext(ctx, "Failed to update post in database", "err", result.Error, "post", obj)
+		return errorsx.ErrDBWrite.WithMessage("%s", result.Error.Error())
+	}
+
+	if result.RowsAffected == 0 {
+		obj.Version = version
+		return errorsx.ErrVersionConflict
 	}
 
 	return nil
 }
 
-// Delete 根据条件删除帖子记录.
+// Delete 根据条件删除帖子记录. 删除的记录会保留在回收站中，由 Purge 永久删除.
 func (s *postStore) Delete(ctx context.Context, opts *where.Options) error {
 	err := s.store.DB(ctx, opts).Delete(new(model.Post)).Error
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-		slog.Error("Failed to delete post from database", "err", err, "conditions", opts)
-		return errorsx.ErrDBWrite.WithMessage(err.Error())
+		slog.ErrorContext(ctx, "Failed to delete post from database", "err", err, "conditions", opts)
+		return errorsx.ErrDBWrite.WithMessage("%s", err.Error())
 	}
 
 	return nil
@@ -81,11 +108,11 @@ func (s *postStore) Delete(ctx context.Context, opts *where.Options) error {
 func (s *postStore) Get(ctx context.Context, opts *where.Options) (*model.Post, error) {
 	var obj model.Post
 	if err := s.store.DB(ctx, opts).First(&obj).Error; err != nil {
-		slog.Error("Failed to retrieve post from database", "err", err, "conditions", opts)
+		slog.ErrorContext(ctx, "Failed to retrieve post from database", "err", err, "conditions", opts)
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errorsx.ErrPostNotFound
 		}
-		return nil, errorsx.ErrDBRead.WithMessage(err.Error())
+		return nil, errorsx.ErrDBRead.WithMessage("%s", err.Error())
 	}
 
 	return &obj, nil
@@ -96,8 +123,85 @@ func (s *postStore) Get(ctx context.Context, opts *where.Options) (*model.Post,
 func (s *postStore) List(ctx context.Context, opts *where.Options) (count int64, ret []*model.Post, err error) {
 	err = s.store.DB(ctx, opts).Order("id desc").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
 	if err != nil {
-		slog.Error("Failed to list posts from database", "err", err, "conditions", opts)
-		err = errorsx.ErrDBRead.WithMessage(err.Error())
+		slog.ErrorContext(ctx, "Failed to list posts from database", "err", err, "conditions", opts)
+		err = errorsx.ErrDBRead.WithMessage("%s", err.Error())
 	}
 	return
 }
+
+// PublishDue 将到期的定时发布博客改为已发布状态，发布时间使用计划的发布时间.
+// 更新条件中包含状态，多个实例同时执行时不会重复发布.
+func (s *postStore) PublishDue(ctx context.Context, now time.Time) (int64, error) {
+	result := s.store.DB(ctx).Model(new(model.Post)).
+		Where("status = ? AND publishAt <= ?", known.PostStatusScheduled, now).
+		Updates(map[string]any{
+			"status":      known.PostStatusPublished,
+			"publishedAt": gorm.Expr("publishAt"),
+			"version":     gorm.Expr("version + 1"),
+		})
+	if result.Error != nil {
+		slog.ErrorContext(ctx, "Failed to publish scheduled posts", "err", result.Error)
+		return 0, errorsx.ErrDBWrite.WithMessage("%s", result.Error.Error())
+	}
+
+	return result.RowsAffected, nil
+}
+
+// Page 对博客进行游标分页查询.
+func (s *postStore) Page(ctx context.Context, opts *where.Options, page *PageOptions) (*Page[model.Post], error) {
+	ret, err := listPage[model.Post](ctx, s.store.DB(ctx, opts), page)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to page posts from database", "err", err, "conditions", opts)
+		return nil, errorsx.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	return ret, nil
+}
+
+// ListTrashed 返回回收站中的博客列表和总数.
+// nolint: nonamedreturns
+func (s *postStore) ListTrashed(ctx context.Context, opts *where.Options) (count int64, ret []*model.Post, err error) {
+	err = s.store.DB(ctx, opts).Unscoped().Where("deletedAt IS NOT NULL").
+		Order("deletedAt desc").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list trashed posts from database", "err", err, "conditions", opts)
+		err = errorsx.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	return
+}
+
+// PageTrashed 对回收站中的博客进行游标分页查询.
+func (s *postStore) PageTrashed(ctx context.Context, opts *where.Options, page *PageOptions) (*Page[model.Post], error) {
+	ret, err := listPage[model.Post](ctx, s.store.DB(ctx, opts).Unscoped().Where("deletedAt IS NOT NULL"), page)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to page trashed posts from database", "err", err, "conditions", opts)
+		return nil, errorsx.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	return ret, nil
+}
+
+// Restore 清空博客的删除时间，并将版本号加 1.
+func (s *postStore) Restore(ctx context.Context, opts *where.Options) (int64, error) {
+	result := s.store.DB(ctx, opts).Unscoped().Model(new(model.Post)).
+		Where("deletedAt IS NOT NULL").
+		Updates(map[string]any{
+			"deletedAt": nil,
+			"version":   gorm.Expr("version + 1"),
+		})
+	if result.Error != nil {
+		slog.ErrorContext(ctx, "Failed to restore posts", "err", result.Error, "conditions", opts)
+		return 0, errorsx.ErrDBWrite.WithMessage("%s", result.Error.Error())
+	}
+
+	return result.RowsAffected, nil
+}
+
+// Purge 永久删除符合条件的博客记录.
+func (s *postStore) Purge(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Unscoped().Delete(new(model.Post)).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		slog.ErrorContext(ctx, "Failed to purge posts from database", "err", err, "conditions", opts)
+		return errorsx.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
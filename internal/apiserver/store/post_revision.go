@@ -0,0 +1,133 @@
+package store
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/onexstack/fastgo/internal/apiserver/model"
+	"github.com/onexstack/fastgo/internal/pkg/errorsx"
+)
+
+// PostRevisionStore 定义了博客修订历史在 store 层所实现的方法.
+// 修订版本一经写入就不可修改，所以没有 Update 方法.
+type PostRevisionStore interface {
+	Create(ctx context.Context, obj *model.PostRevision) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.PostRevision, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.PostRevision, error)
+
+	PostRevisionExpansion
+}
+
+// PostRevisionExpansion 定义了博客修订历史操作的附加方法.
+type PostRevisionExpansion interface {
+	// Page 按照指定的排序字段对修订版本进行游标分页查询.
+	Page(ctx context.Context, opts *where.Options, page *PageOptions) (*Page[model.PostRevision], error)
+	// LatestRevision 返回博客最新的修订版本号，没有修订版本时返回 0.
+	LatestRevision(ctx context.Context, postID string) (int64, error)
+	// Prune 只保留博客最新的 keep 个修订版本，删除更早的版本.
+	Prune(ctx context.Context, postID string, keep int) error
+}
+
+// postRevisionStore 是 PostRevisionStore 接口的实现.
+type postRevisionStore struct {
+	store *datastore
+}
+
+// 确保 postRevisionStore 实现了 PostRevisionStore 接口.
+var _ PostRevisionStore = (*postRevisionStore)(nil)
+
+// newPostRevisionStore 创建 postRevisionStore 的实例.
+func newPostRevisionStore(store *datastore) *postRevisionStore {
+	return &postRevisionStore{store}
+}
+
+// Create 插入一条修订版本记录.
+func (s *postRevisionStore) Create(ctx context.Context, obj *model.PostRevision) error {
+	if err := s.store.DB(ctx).Create(&obj).Error; err != nil {
+		slog.ErrorContext(ctx, "Failed to insert post revision into database", "err", err, "postID", obj.PostID, "revision", obj.Revision)
+		return errorsx.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Delete 根据条件删除修订版本记录.
+func (s *postRevisionStore) Delete(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Delete(new(model.PostRevision)).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		slog.ErrorContext(ctx, "Failed to delete post revisions from database", "err", err, "conditions", opts)
+		return errorsx.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Get 根据条件查询修订版本记录.
+func (s *postRevisionStore) Get(ctx context.Context, opts *where.Options) (*model.PostRevision, error) {
+	var obj model.PostRevision
+	if err := s.store.DB(ctx, opts).First(&obj).Error; err != nil {
+		slog.ErrorContext(ctx, "Failed to retrieve post revision from database", "err", err, "conditions", opts)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorsx.ErrPostRevisionNotFound
+		}
+		return nil, errorsx.ErrDBRead.WithMessage("%s", err.Error())
+	}
+
+	return &obj, nil
+}
+
+// List 返回修订版本列表和总数，按照版本号倒序排列.
+// nolint: nonamedreturns
+func (s *postRevisionStore) List(ctx context.Context, opts *where.Options) (count int64, ret []*model.PostRevision, err error) {
+	err = s.store.DB(ctx, opts).Order("revision desc").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list post revisions from database", "err", err, "conditions", opts)
+		err = errorsx.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	return
+}
+
+// LatestRevision 返回博客最新的修订版本号.
+func (s *postRevisionStore) LatestRevision(ctx context.Context, postID string) (int64, error) {
+	var latest int64
+	err := s.store.DB(ctx).Model(new(model.PostRevision)).
+		Where("postID = ?", postID).
+		Select("COALESCE(MAX(revision), 0)").
+		Scan(&latest).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to query latest post revision", "err", err, "postID", postID)
+		return 0, errorsx.ErrDBRead.WithMessage("%s", err.Error())
+	}
+
+	return latest, nil
+}
+
+// Prune 删除博客最新的 keep 个修订版本之外的所有版本，keep 小于等于 0 时不删除.
+// 版本号连续递增，所以只需删除版本号不大于 latest-keep 的记录.
+func (s *postRevisionStore) Prune(ctx context.Context, postID string, keep int) error {
+	if keep <= 0 {
+		return nil
+	}
+
+	latest, err := s.LatestRevision(ctx, postID)
+	if err != nil {
+		return err
+	}
+
+	return s.Delete(ctx, where.F("postID", postID).Q("revision <= ?", latest-int64(keep)))
+}
+
+// Page 对修订版本进行游标分页查询.
+func (s *postRevisionStore) Page(ctx context.Context, opts *where.Options, page *PageOptions) (*Page[model.PostRevision], error) {
+	ret, err := listPage[model.PostRevision](ctx, s.store.DB(ctx, opts), page)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to page post revisions from database", "err", err, "conditions", opts)
+		return nil, errorsx.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	return ret, nil
+}
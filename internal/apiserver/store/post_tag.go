@@ -0,0 +1,141 @@
+package store
+
+import (
+	"context"
+	"log/slog"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/onexstack/fastgo/internal/apiserver/model"
+	"github.com/onexstack/fastgo/internal/pkg/errorsx"
+)
+
+// PostTagStore 定义了博客和标签关联关系在 store 层所实现的方法.
+// 这些方法通常会修改多张表，调用方应在 IStore.TX 中调用.
+type PostTagStore interface {
+	// Replace 将博客的标签替换为给定的标签.
+	Replace(ctx context.Context, postID string, tagIDs ...int64) error
+	// DeleteByPosts 删除给定博客的所有标签关联.
+	DeleteByPosts(ctx context.Context, postIDs ...string) error
+	// TagNames 批量查询博客的标签名称，返回博客 ID 到标签名称列表的映射.
+	TagNames(ctx context.Context, postIDs ...string) (map[string][]string, error)
+	// Reassign 将关联到 fromTagIDs 的博客改为关联到 toTagID，用于合并标签.
+	Reassign(ctx context.Context, fromTagIDs []int64, toTagID int64) error
+	// TouchPosts 将关联了给定标签的博客（包括回收站中的博客）的版本号加 1，用于标签变更后使博客的 ETag 失效.
+	TouchPosts(ctx context.Context, tagIDs ...int64) error
+
+	PostTagExpansion
+}
+
+// PostTagExpansion 定义了博客标签关联操作的附加方法.
+type PostTagExpansion interface{}
+
+// postTagStore 是 PostTagStore 接口的实现.
+type postTagStore struct {
+	store *datastore
+}
+
+// 确保 postTagStore 实现了 PostTagStore 接口.
+var _ PostTagStore = (*postTagStore)(nil)
+
+// newPostTagStore 创建 postTagStore 的实例.
+func newPostTagStore(store *datastore) *postTagStore {
+	return &postTagStore{store}
+}
+
+// Replace 先删除博客已有的标签关联，再插入新的标签关联.
+func (s *postTagStore) Replace(ctx context.Context, postID string, tagIDs ...int64) error {
+	if err := s.DeleteByPosts(ctx, postID); err != nil {
+		return err
+	}
+
+	if len(tagIDs) == 0 {
+		return nil
+	}
+
+	objs := make([]*model.PostTag, 0, len(tagIDs))
+	for _, tagID := range tagIDs {
+		objs = append(objs, &model.PostTag{PostID: postID, TagID: tagID})
+	}
+
+	if err := s.store.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&objs).Error; err != nil {
+		slog.ErrorContext(ctx, "Failed to insert post tags into database", "err", err, "postID", postID)
+		return errorsx.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// DeleteByPosts 删除给定博客的所有标签关联.
+func (s *postTagStore) DeleteByPosts(ctx context.Context, postIDs ...string) error {
+	if err := s.store.DB(ctx).Where("postID IN ?", postIDs).Delete(new(model.PostTag)).Error; err != nil {
+		slog.ErrorContext(ctx, "Failed to delete post tags from database", "err", err, "postIDs", postIDs)
+		return errorsx.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// TagNames 批量查询博客的标签名称，每篇博客的标签按照名称排序.
+func (s *postTagStore) TagNames(ctx context.Context, postIDs ...string) (map[string][]string, error) {
+	var rows []struct {
+		PostID string `gorm:"column:postID"`
+		Name   string `gorm:"column:name"`
+	}
+
+	err := s.store.DB(ctx).Table(model.TableNamePostTag+" AS pt").
+		Select("pt.postID, t.name").
+		Joins("JOIN "+model.TableNameTag+" AS t ON t.id = pt.tagID").
+		Where("pt.postID IN ?", postIDs).
+		Order("t.name").
+		Scan(&rows).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list post tags from database", "err", err)
+		return nil, errorsx.ErrDBRead.WithMessage("%s", err.Error())
+	}
+
+	names := make(map[string][]string, len(postIDs))
+	for _, row := range rows {
+		names[row.PostID] = append(names[row.PostID], row.Name)
+	}
+
+	return names, nil
+}
+
+// Reassign 将关联到 fromTagIDs 的博客改为关联到 toTagID.
+// 已经关联了目标标签的博客不会重复关联.
+func (s *postTagStore) Reassign(ctx context.Context, fromTagIDs []int64, toTagID int64) error {
+	db := s.store.DB(ctx)
+
+	err := db.Exec(
+		"INSERT INTO "+model.TableNamePostTag+" (postID, tagID) "+
+			"SELECT DISTINCT postID, ? FROM "+model.TableNamePostTag+
+			" WHERE tagID IN ? AND postID NOT IN (SELECT postID FROM "+model.TableNamePostTag+" WHERE tagID = ?)",
+		toTagID, fromTagIDs, toTagID,
+	).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to reassign post tags", "err", err, "from", fromTagIDs, "to", toTagID)
+		return errorsx.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	if err := db.Where("tagID IN ?", fromTagIDs).Delete(new(model.PostTag)).Error; err != nil {
+		slog.ErrorContext(ctx, "Failed to delete reassigned post tags", "err", err, "from", fromTagIDs)
+		return errorsx.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// TouchPosts 将关联了给定标签的博客的版本号加 1.
+func (s *postTagStore) TouchPosts(ctx context.Context, tagIDs ...int64) error {
+	err := s.store.DB(ctx).Unscoped().Model(new(model.Post)).
+		Where("postID IN (?)", s.store.DB(ctx).Model(new(model.PostTag)).Select("postID").Where("tagID IN ?", tagIDs)).
+		UpdateColumn("version", gorm.Expr("version + 1")).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to update the version of tagged posts", "err", err, "tagIDs", tagIDs)
+		return errorsx.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
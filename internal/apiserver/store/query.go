@@ -0,0 +1,135 @@
+package store
+
+import (
+	"github.com/onexstack/fastgo/internal/pkg/query"
+)
+
+// 以下 Schema 定义了各个列表接口允许过滤（filter）和排序（orderBy、sortBy）的字段.
+// 只有列在这里的字段可以出现在查询条件中，新增字段时需要确认该字段可以对调用方公开.
+var (
+	// UserSchema 定义了用户列表允许查询的字段，默认按照创建时间倒序排列.
+	UserSchema = &query.Schema{
+		Fields: map[string]query.Field{
+			"userID":              {Column: "userID"},
+			"username":            {Column: "username"},
+			"nickname":            {Column: "nickname"},
+			"email":               {Column: "email"},
+			"phone":               {Column: "phone"},
+			"role":                {Column: "role"},
+			"deletionScheduledAt": {Column: "deletionScheduledAt", Type: query.Time, Nullable: true},
+			"createdAt":           {Column: "createdAt", Type: query.Time, Sortable: true},
+			"updatedAt":           {Column: "updatedAt", Type: query.Time, Sortable: true},
+		},
+		DefaultSort: "createdAt",
+		DefaultDesc: true,
+	}
+
+	// TrashedUserSchema 定义了回收站用户列表允许查询的字段，默认按照删除时间倒序排列.
+	TrashedUserSchema = trashedSchema(UserSchema)
+
+	// PostSchema 定义了博客列表允许查询的字段，默认按照创建时间倒序排列.
+	PostSchema = &query.Schema{
+		Fields: map[string]query.Field{
+			"postID":      {Column: "postID"},
+			"userID":      {Column: "userID"},
+			"title":       {Column: "title"},
+			"content":     {Column: "content"},
+			"categoryID":  {Column: "categoryID"},
+			"status":      {Column: "status"},
+			"visibility":  {Column: "visibility"},
+			"version":     {Column: "version", Type: query.Int},
+			"publishAt":   {Column: "publishAt", Type: query.Time, Nullable: true},
+			"publishedAt": {Column: "publishedAt", Type: query.Time, Nullable: true},
+			"createdAt":   {Column: "createdAt", Type: query.Time, Sortable: true},
+			"updatedAt":   {Column: "updatedAt", Type: query.Time, Sortable: true},
+		},
+		DefaultSort: "createdAt",
+		DefaultDesc: true,
+	}
+
+	// TrashedPostSchema 定义了回收站博客列表允许查询的字段，默认按照删除时间倒序排列.
+	TrashedPostSchema = trashedSchema(PostSchema)
+
+	// PublicPostSchema 定义了公开博客列表允许查询的字段，只支持按照发布时间倒序排列.
+	PublicPostSchema = &query.Schema{
+		Fields: map[string]query.Field{
+			"postID":      {Column: "postID"},
+			"title":       {Column: "title"},
+			"publishedAt": {Column: "publishedAt", Type: query.Time, Sortable: true},
+		},
+		DefaultSort: "publishedAt",
+		DefaultDesc: true,
+	}
+
+	// CommentSchema 定义了评论列表允许查询的字段，默认按照创建时间正序排列，方便组织评论树.
+	CommentSchema = &query.Schema{
+		Fields: map[string]query.Field{
+			"commentID": {Column: "commentID"},
+			"userID":    {Column: "userID"},
+			"parentID":  {Column: "parentID"},
+			"content":   {Column: "content"},
+			"deletedAt": {Column: "deletedAt", Type: query.Time, Nullable: true},
+			"createdAt": {Column: "createdAt", Type: query.Time, Sortable: true},
+			"updatedAt": {Column: "updatedAt", Type: query.Time, Sortable: true},
+		},
+		DefaultSort: "createdAt",
+	}
+
+	// PostRevisionSchema 定义了博客修订版本列表允许查询的字段，默认按照版本号倒序排列.
+	PostRevisionSchema = &query.Schema{
+		Fields: map[string]query.Field{
+			"revision":  {Column: "revision", Type: query.Int, Sortable: true},
+			"userID":    {Column: "userID"},
+			"title":     {Column: "title"},
+			"createdAt": {Column: "createdAt", Type: query.Time, Sortable: true},
+		},
+		DefaultSort: "revision",
+		DefaultDesc: true,
+	}
+
+	// TagSchema 定义了标签列表允许查询的字段，默认按照名称正序排列.
+	TagSchema = &query.Schema{
+		Fields: map[string]query.Field{
+			"name":      {Column: "name", Sortable: true},
+			"createdAt": {Column: "createdAt", Type: query.Time, Sortable: true},
+			"updatedAt": {Column: "updatedAt", Type: query.Time, Sortable: true},
+		},
+		DefaultSort: "name",
+	}
+
+	// CategorySchema 定义了分类列表允许查询的字段，默认按照分类路径正序排列，子分类紧跟在父分类之后.
+	CategorySchema = &query.Schema{
+		Fields: map[string]query.Field{
+			"categoryID": {Column: "categoryID"},
+			"parentID":   {Column: "parentID"},
+			"name":       {Column: "name", Sortable: true},
+			"path":       {Column: "path", Sortable: true},
+			"createdAt":  {Column: "createdAt", Type: query.Time, Sortable: true},
+			"updatedAt":  {Column: "updatedAt", Type: query.Time, Sortable: true},
+		},
+		DefaultSort: "path",
+	}
+
+	// PolicySchema 定义了授权策略列表允许查询的字段，默认按照创建时间倒序排列.
+	PolicySchema = &query.Schema{
+		Fields: map[string]query.Field{
+			"role":      {Column: "role"},
+			"path":      {Column: "path"},
+			"method":    {Column: "method"},
+			"createdAt": {Column: "createdAt", Type: query.Time, Sortable: true},
+			"updatedAt": {Column: "updatedAt", Type: query.Time, Sortable: true},
+		},
+		DefaultSort: "createdAt",
+		DefaultDesc: true,
+	}
+)
+
+// trashedSchema 在 s 的基础上增加删除时间字段，并默认按照删除时间倒序排列.
+func trashedSchema(s *query.Schema) *query.Schema {
+	ret := s.With(map[string]query.Field{
+		"deletedAt": {Column: "deletedAt", Type: query.Time, Sortable: true},
+	})
+	ret.DefaultSort = "deletedAt"
+	ret.DefaultDesc = true
+	return ret
+}
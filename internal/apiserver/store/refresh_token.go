@@ -0,0 +1,104 @@
+// nolint: dupl
+package store
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"time"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm"
+
+	"github.com/onexstack/fastgo/internal/apiserver/model"
+	"github.com/onexstack/fastgo/internal/pkg/errorsx"
+)
+
+// RefreshTokenStore 定义了 refresh token 模块在 store 层所实现的方法.
+type RefreshTokenStore interface {
+	Create(ctx context.Context, obj *model.RefreshToken) error
+	Get(ctx context.Context, opts *where.Options) (*model.RefreshToken, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.RefreshToken, error)
+	Delete(ctx context.Context, opts *where.Options) error
+
+	RefreshTokenExpansion
+}
+
+// RefreshTokenExpansion 定义了刷新令牌操作的附加方法.
+type RefreshTokenExpansion interface {
+	// Revoke 吊销所有符合条件且尚未吊销的刷新令牌，并返回被吊销的数量.
+	Revoke(ctx context.Context, opts *where.Options) (int64, error)
+}
+
+// refreshTokenStore 是 RefreshTokenStore 接口的实现.
+type refreshTokenStore struct {
+	store *datastore
+}
+
+// 确保 refreshTokenStore 实现了 RefreshTokenStore 接口.
+var _ RefreshTokenStore = (*refreshTokenStore)(nil)
+
+// newRefreshTokenStore 创建 refreshTokenStore 的实例.
+func newRefreshTokenStore(store *datastore) *refreshTokenStore {
+	return &refreshTokenStore{store}
+}
+
+// Create 插入一条刷新令牌记录.
+func (s *refreshTokenStore) Create(ctx context.Context, obj *model.RefreshToken) error {
+	if err := s.store.DB(ctx).Create(&obj).Error; err != nil {
+		slog.ErrorContext(ctx, "Failed to insert refresh token into database", "err", err, "userID", obj.UserID)
+		return errorsx.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Get 根据条件查询刷新令牌记录.
+func (s *refreshTokenStore) Get(ctx context.Context, opts *where.Options) (*model.RefreshToken, error) {
+	var obj model.RefreshToken
+	if err := s.store.DB(ctx, opts).First(&obj).Error; err != nil {
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorsx.ErrRefreshTokenInvalid
+		}
+		slog.ErrorContext(ctx, "Failed to retrieve refresh token from database", "err", err)
+		return nil, errorsx.ErrDBRead.WithMessage("%s", err.Error())
+	}
+
+	return &obj, nil
+}
+
+// List 返回刷新令牌列表和总数.
+// nolint: nonamedreturns
+func (s *refreshTokenStore) List(ctx context.Context, opts *where.Options) (count int64, ret []*model.RefreshToken, err error) {
+	err = s.store.DB(ctx, opts).Order("id desc").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list refresh tokens from database", "err", err, "conditions", opts)
+		err = errorsx.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	return
+}
+
+// Delete 根据条件删除刷新令牌记录.
+func (s *refreshTokenStore) Delete(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Delete(new(model.RefreshToken)).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		slog.ErrorContext(ctx, "Failed to delete refresh tokens from database", "err", err, "conditions", opts)
+		return errorsx.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Revoke 吊销所有符合条件且尚未吊销的刷新令牌.
+// 通过 revokedAt IS NULL 条件更新，保证并发轮换同一个刷新令牌时只有一个请求能成功.
+func (s *refreshTokenStore) Revoke(ctx context.Context, opts *where.Options) (int64, error) {
+	result := s.store.DB(ctx, opts).Model(new(model.RefreshToken)).
+		Where("revokedAt IS NULL").
+		Update("revokedAt", time.Now())
+	if result.Error != nil {
+		slog.ErrorContext(ctx, "Failed to revoke refresh tokens", "err", result.Error, "conditions", opts)
+		return 0, errorsx.ErrDBWrite.WithMessage("%s", result.Error.Error())
+	}
+
+	return result.RowsAffected, nil
+}
@@ -0,0 +1,79 @@
+package store
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/onexstack/fastgo/internal/apiserver/model"
+	"github.com/onexstack/fastgo/internal/pkg/errorsx"
+	"github.com/onexstack/fastgo/pkg/token"
+)
+
+// RevokedTokenStore 定义了访问令牌吊销列表在 store 层所实现的方法.
+type RevokedTokenStore interface {
+	Create(ctx context.Context, obj *model.RevokedToken) error
+
+	RevokedTokenExpansion
+}
+
+// RevokedTokenExpansion 定义了访问令牌吊销列表的附加方法.
+type RevokedTokenExpansion interface {
+	// IsRevoked 判断给定的令牌 ID 或会话 ID 中是否有已被吊销的.
+	IsRevoked(ctx context.Context, ids ...string) (bool, error)
+	// DeleteExpired 删除所有已过期的吊销记录.
+	DeleteExpired(ctx context.Context) error
+}
+
+// revokedTokenStore 是 RevokedTokenStore 接口的实现.
+type revokedTokenStore struct {
+	store *datastore
+}
+
+var (
+	// 确保 revokedTokenStore 实现了 RevokedTokenStore 接口.
+	_ RevokedTokenStore = (*revokedTokenStore)(nil)
+	// 确保 revokedTokenStore 可以作为 token 包的吊销列表使用.
+	_ token.Denylist = (*revokedTokenStore)(nil)
+)
+
+// newRevokedTokenStore 创建 revokedTokenStore 的实例.
+func newRevokedTokenStore(store *datastore) *revokedTokenStore {
+	return &revokedTokenStore{store}
+}
+
+// Create 插入一条吊销记录，重复吊销同一个 ID 时忽略.
+func (s *revokedTokenStore) Create(ctx context.Context, obj *model.RevokedToken) error {
+	if err := s.store.DB(ctx).Clauses(clause.OnConflict{DoNothing: true}).Create(&obj).Error; err != nil {
+		slog.ErrorContext(ctx, "Failed to insert revoked token into database", "err", err, "tokenID", obj.TokenID)
+		return errorsx.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// IsRevoked 判断给定的令牌 ID 或会话 ID 中是否有尚未过期的吊销记录.
+func (s *revokedTokenStore) IsRevoked(ctx context.Context, ids ...string) (bool, error) {
+	var count int64
+	err := s.store.DB(ctx).Model(new(model.RevokedToken)).
+		Where("tokenID IN ? AND expiresAt > ?", ids, time.Now()).
+		Count(&count).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to query revoked tokens", "err", err)
+		return false, errorsx.ErrDBRead.WithMessage("%s", err.Error())
+	}
+
+	return count > 0, nil
+}
+
+// DeleteExpired 删除所有已过期的吊销记录，过期后对应的访问令牌已经自然失效.
+func (s *revokedTokenStore) DeleteExpired(ctx context.Context) error {
+	if err := s.store.DB(ctx).Where("expiresAt <= ?", time.Now()).Delete(new(model.RevokedToken)).Error; err != nil {
+		slog.ErrorContext(ctx, "Failed to delete expired revoked tokens", "err", err)
+		return errorsx.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
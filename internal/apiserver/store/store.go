@@ -24,6 +24,14 @@ type IStore interface {
 
 	User() UserStore
 	Post() PostStore
+	Policy() PolicyStore
+	Tag() TagStore
+	PostTag() PostTagStore
+	PostRevision() PostRevisionStore
+	Category() CategoryStore
+	Comment() CommentStore
+	RefreshToken() RefreshTokenStore
+	RevokedToken() RevokedTokenStore
 }
 
 // transactionKey 用于在 context.Context 中存储事务上下文的键.
@@ -53,10 +61,11 @@ func NewStore(db *gorm.DB) *datastore {
 // DB 根据传入的条件（wheres）对数据库实例进行筛选.
 // 如果未传入任何条件，则返回上下文中的数据库实例（事务实例或核心数据库实例）.
 func (store *datastore) DB(ctx context.Context, wheres ...where.Where) *gorm.DB {
-	db := store.core
+	// 将上下文传给 GORM，SQL 的 span 会挂在当前请求的 span 下，请求取消时也会中断查询
+	db := store.core.WithContext(ctx)
 	// 从上下文中提取事务实例
 	if tx, ok := ctx.Value(transactionKey{}).(*gorm.DB); ok {
-		db = tx
+		db = tx.WithContext(ctx)
 	}
 
 	// 遍历所有传入的条件并逐一叠加到数据库查询对象上
@@ -86,3 +95,43 @@ func (store *datastore) User() UserStore {
 func (store *datastore) Post() PostStore {
 	return newPostStore(store)
 }
+
+// Policy 返回一个实现了 PolicyStore 接口的实例.
+func (store *datastore) Policy() PolicyStore {
+	return newPolicyStore(store)
+}
+
+// Tag 返回一个实现了 TagStore 接口的实例.
+func (store *datastore) Tag() TagStore {
+	return newTagStore(store)
+}
+
+// PostTag 返回一个实现了 PostTagStore 接口的实例.
+func (store *datastore) PostTag() PostTagStore {
+	return newPostTagStore(store)
+}
+
+// PostRevision 返回一个实现了 PostRevisionStore 接口的实例.
+func (store *datastore) PostRevision() PostRevisionStore {
+	return newPostRevisionStore(store)
+}
+
+// Category 返回一个实现了 CategoryStore 接口的实例.
+func (store *datastore) Category() CategoryStore {
+	return newCategoryStore(store)
+}
+
+// Comment 返回一个实现了 CommentStore 接口的实例.
+func (store *datastore) Comment() CommentStore {
+	return newCommentStore(store)
+}
+
+// RefreshToken 返回一个实现了 RefreshTokenStore 接口的实例.
+func (store *datastore) RefreshToken() RefreshTokenStore {
+	return newRefreshTokenStore(store)
+}
+
+// RevokedToken 返回一个实现了 RevokedTokenStore 接口的实例.
+func (store *datastore) RevokedToken() RevokedTokenStore {
+	return newRevokedTokenStore(store)
+}
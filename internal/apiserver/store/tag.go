@@ -0,0 +1,168 @@
+// nolint: dupl
+package store
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+
+	"github.com/onexstack/onexstack/pkg/store/where"
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/onexstack/fastgo/internal/apiserver/model"
+	"github.com/onexstack/fastgo/internal/pkg/errorsx"
+)
+
+// TagStore 定义了 tag 模块在 store 层所实现的方法.
+type TagStore interface {
+	Create(ctx context.Context, obj *model.Tag) error
+	Update(ctx context.Context, obj *model.Tag) error
+	Delete(ctx context.Context, opts *where.Options) error
+	Get(ctx context.Context, opts *where.Options) (*model.Tag, error)
+	List(ctx context.Context, opts *where.Options) (int64, []*model.Tag, error)
+
+	TagExpansion
+}
+
+// TagExpansion 定义了标签操作的附加方法.
+type TagExpansion interface {
+	// FindOrCreate 返回给定名称的标签，不存在的标签会被自动创建.
+	FindOrCreate(ctx context.Context, names ...string) ([]*model.Tag, error)
+	// PostCounts 统计每个标签关联的博客数量，返回标签 ID 到博客数量的映射.
+	PostCounts(ctx context.Context, tagIDs ...int64) (map[int64]int64, error)
+	// Page 按照指定的排序字段对标签进行游标分页查询.
+	Page(ctx context.Context, opts *where.Options, page *PageOptions) (*Page[model.Tag], error)
+}
+
+// tagStore 是 TagStore 接口的实现.
+type tagStore struct {
+	store *datastore
+}
+
+// 确保 tagStore 实现了 TagStore 接口.
+var _ TagStore = (*tagStore)(nil)
+
+// newTagStore 创建 tagStore 的实例.
+func newTagStore(store *datastore) *tagStore {
+	return &tagStore{store}
+}
+
+// Create 插入一条标签记录.
+func (s *tagStore) Create(ctx context.Context, obj *model.Tag) error {
+	if err := s.store.DB(ctx).Create(&obj).Error; err != nil {
+		slog.ErrorContext(ctx, "Failed to insert tag into database", "err", err, "tag", obj)
+		return errorsx.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Update 更新标签数据库记录.
+func (s *tagStore) Update(ctx context.Context, obj *model.Tag) error {
+	if err := s.store.DB(ctx).Save(obj).Error; err != nil {
+		slog.ErrorContext(ctx, "Failed to update tag in database", "err", err, "tag", obj)
+		return errorsx.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Delete 根据条件删除标签记录.
+func (s *tagStore) Delete(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Delete(new(model.Tag)).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		slog.ErrorContext(ctx, "Failed to delete tag from database", "err", err, "conditions", opts)
+		return errorsx.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
+
+// Get 根据条件查询标签记录.
+func (s *tagStore) Get(ctx context.Context, opts *where.Options) (*model.Tag, error) {
+	var obj model.Tag
+	if err := s.store.DB(ctx, opts).First(&obj).Error; err != nil {
+		slog.ErrorContext(ctx, "Failed to retrieve tag from database", "err", err, "conditions", opts)
+		if errors.Is(err, gorm.ErrRecordNotFound) {
+			return nil, errorsx.ErrTagNotFound
+		}
+		return nil, errorsx.ErrDBRead.WithMessage("%s", err.Error())
+	}
+
+	return &obj, nil
+}
+
+// List 返回标签列表和总数，按照标签名称排序.
+// nolint: nonamedreturns
+func (s *tagStore) List(ctx context.Context, opts *where.Options) (count int64, ret []*model.Tag, err error) {
+	err = s.store.DB(ctx, opts).Order("name").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list tags from database", "err", err, "conditions", opts)
+		err = errorsx.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	return
+}
+
+// FindOrCreate 返回给定名称的标签，不存在的标签会被自动创建.
+// 并发创建同名标签时依赖唯一索引去重，不会返回错误.
+func (s *tagStore) FindOrCreate(ctx context.Context, names ...string) ([]*model.Tag, error) {
+	if len(names) == 0 {
+		return nil, nil
+	}
+
+	tags := make([]*model.Tag, 0, len(names))
+	for _, name := range names {
+		tags = append(tags, &model.Tag{Name: name})
+	}
+
+	db := s.store.DB(ctx)
+	if err := db.Clauses(clause.OnConflict{DoNothing: true}).Create(&tags).Error; err != nil {
+		slog.ErrorContext(ctx, "Failed to insert tags into database", "err", err, "names", names)
+		return nil, errorsx.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	var ret []*model.Tag
+	if err := s.store.DB(ctx).Where("name IN ?", names).Find(&ret).Error; err != nil {
+		slog.ErrorContext(ctx, "Failed to retrieve tags from database", "err", err, "names", names)
+		return nil, errorsx.ErrDBRead.WithMessage("%s", err.Error())
+	}
+
+	return ret, nil
+}
+
+// PostCounts 统计每个标签关联的博客数量，回收站中的博客不计入.
+func (s *tagStore) PostCounts(ctx context.Context, tagIDs ...int64) (map[int64]int64, error) {
+	var rows []struct {
+		TagID int64 `gorm:"column:tagID"`
+		Count int64 `gorm:"column:count"`
+	}
+
+	err := s.store.DB(ctx).Model(new(model.PostTag)).
+		Select("post_tag.tagID, COUNT(*) AS count").
+		Joins("JOIN post ON post.postID = post_tag.postID AND post.deletedAt IS NULL").
+		Where("post_tag.tagID IN ?", tagIDs).
+		Group("post_tag.tagID").
+		Scan(&rows).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to count posts by tag", "err", err)
+		return nil, errorsx.ErrDBRead.WithMessage("%s", err.Error())
+	}
+
+	counts := make(map[int64]int64, len(rows))
+	for _, row := range rows {
+		counts[row.TagID] = row.Count
+	}
+
+	return counts, nil
+}
+
+// Page 对标签进行游标分页查询.
+func (s *tagStore) Page(ctx context.Context, opts *where.Options, page *PageOptions) (*Page[model.Tag], error) {
+	ret, err := listPage[model.Tag](ctx, s.store.DB(ctx, opts), page)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to page tags from database", "err", err, "conditions", opts)
+		return nil, errorsx.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	return ret, nil
+}
@@ -31,7 +31,18 @@ type UserStore interface {
 }
 
 // UserExpansion 定义了用户操作的附加方法.
-type UserExpansion interface{}
+type UserExpansion interface {
+	// Page 按照指定的排序字段对用户进行游标分页查询.
+	Page(ctx context.Context, opts *where.Options, page *PageOptions) (*Page[model.User], error)
+	// PageTrashed 按照指定的排序字段对回收站中的用户进行游标分页查询.
+	PageTrashed(ctx context.Context, opts *where.Options, page *PageOptions) (*Page[model.User], error)
+	// ListTrashed 返回回收站中的用户列表和总数，按照删除时间倒序排列.
+	ListTrashed(ctx context.Context, opts *where.Options) (int64, []*model.User, error)
+	// Restore 将回收站中符合条件的用户恢复，返回恢复的用户数量.
+	Restore(ctx context.Context, opts *where.Options) (int64, error)
+	// Purge 永久删除符合条件的用户，包括回收站中的用户.
+	Purge(ctx context.Context, opts *where.Options) error
+}
 
 // userStore 是 UserStore 接口的实现.
 type userStore struct {
@@ -49,29 +60,41 @@ func newUserStore(store *datastore) *userStore {
 // Create 插入一条用户记录.
 func (s *userStore) Create(ctx context.Context, obj *model.User) error {
 	if err := s.store.DB(ctx).Create(&obj).Error; err != nil {
-		slog.Error("Failed to insert user into database", "err", err, "user", obj)
-		return errorsx.ErrDBWrite.WithMessage(err.Error())
+		slog.ErrorContext(ctx, "Failed to insert user into database", "err", err, "user", obj)
+		return errorsx.ErrDBWrite.WithMessage("%s", err.Error())
 	}
 
 	return nil
 }
 
-// Update 更新用户数据库记录.
+// Update 更新用户数据库记录，并将版本号加 1.
+// 更新条件中包含读取时的版本号，记录在读取之后被其他请求修改时返回 ErrVersionConflict.
 func (s *userStore) Update(ctx context.Context, obj *model.User) error {
-	if err := s.store.DB(ctx).Save(obj).Error; err != nil {
-		slog.Error("Failed to update user in database", "err", err, "user", obj)
-		return errorsx.ErrDBWrite.WithMessage(err.Error())
+	version := obj.Version
+	obj.Version++
+
+	// 指定 Select 后 Save 只执行 UPDATE，不会在没有匹配记录时改为插入
+	result := s.store.DB(ctx).Select("*").Where("version = ?", version).Save(obj)
+	if result.Error != nil {
+		obj.Version = version
+		slog.ErrorContext(ctx, "Failed to update user in database", "err", result.Error, "user", obj)
+		return errorsx.ErrDBWrite.WithMessage("%s", result.Error.Error())
+	}
+
+	if result.RowsAffected == 0 {
+		obj.Version = version
+		return errorsx.ErrVersionConflict
 	}
 
 	return nil
 }
 
-// Delete 根据条件删除用户记录.
+// Delete 根据条件删除用户记录. 删除的记录会保留在回收站中，由 Purge 永久删除.
 func (s *userStore) Delete(ctx context.Context, opts *where.Options) error {
 	err := s.store.DB(ctx, opts).Delete(new(model.User)).Error
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
-		slog.Error("Failed to delete user from database", "err", err, "conditions", opts)
-		return errorsx.ErrDBWrite.WithMessage(err.Error())
+		slog.ErrorContext(ctx, "Failed to delete user from database", "err", err, "conditions", opts)
+		return errorsx.ErrDBWrite.WithMessage("%s", err.Error())
 	}
 
 	return nil
@@ -81,11 +104,11 @@ func (s *userStore) Delete(ctx context.Context, opts *where.Options) error {
 func (s *userStore) Get(ctx context.Context, opts *where.Options) (*model.User, error) {
 	var obj model.User
 	if err := s.store.DB(ctx, opts).First(&obj).Error; err != nil {
-		slog.Error("Failed to retrieve user from database", "err", err, "conditions", opts)
+		slog.ErrorContext(ctx, "Failed to retrieve user from database", "err", err, "conditions", opts)
 		if errors.Is(err, gorm.ErrRecordNotFound) {
 			return nil, errorsx.ErrUserNotFound
 		}
-		return nil, errorsx.ErrDBRead.WithMessage(err.Error())
+		return nil, errorsx.ErrDBRead.WithMessage("%s", err.Error())
 	}
 
 	return &obj, nil
@@ -96,8 +119,69 @@ func (s *userStore) Get(ctx context.Context, opts *where.Options) (*model.User,
 func (s *userStore) List(ctx context.Context, opts *where.Options) (count int64, ret []*model.User, err error) {
 	err = s.store.DB(ctx, opts).Order("id desc").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
 	if err != nil {
-		slog.Error("Failed to list users from database", "err", err, "conditions", opts)
-		err = errorsx.ErrDBRead.WithMessage(err.Error())
+		slog.ErrorContext(ctx, "Failed to list users from database", "err", err, "conditions", opts)
+		err = errorsx.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	return
+}
+
+// Page 对用户进行游标分页查询.
+func (s *userStore) Page(ctx context.Context, opts *where.Options, page *PageOptions) (*Page[model.User], error) {
+	ret, err := listPage[model.User](ctx, s.store.DB(ctx, opts), page)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to page users from database", "err", err, "conditions", opts)
+		return nil, errorsx.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	return ret, nil
+}
+
+// ListTrashed 返回回收站中的用户列表和总数.
+// nolint: nonamedreturns
+func (s *userStore) ListTrashed(ctx context.Context, opts *where.Options) (count int64, ret []*model.User, err error) {
+	err = s.store.DB(ctx, opts).Unscoped().Where("deletedAt IS NOT NULL").
+		Order("deletedAt desc").Find(&ret).Offset(-1).Limit(-1).Count(&count).Error
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to list trashed users from database", "err", err, "conditions", opts)
+		err = errorsx.ErrDBRead.WithMessage("%s", err.Error())
 	}
 	return
 }
+
+// PageTrashed 对回收站中的用户进行游标分页查询.
+func (s *userStore) PageTrashed(ctx context.Context, opts *where.Options, page *PageOptions) (*Page[model.User], error) {
+	ret, err := listPage[model.User](ctx, s.store.DB(ctx, opts).Unscoped().Where("deletedAt IS NOT NULL"), page)
+	if err != nil {
+		slog.ErrorContext(ctx, "Failed to page trashed users from database", "err", err, "conditions", opts)
+		return nil, errorsx.ErrDBRead.WithMessage("%s", err.Error())
+	}
+	return ret, nil
+}
+
+// Restore 清空用户的删除时间和计划删除时间，并将版本号加 1.
+func (s *userStore) Restore(ctx context.Context, opts *where.Options) (int64, error) {
+	result := s.store.DB(ctx, opts).Unscoped().Model(new(model.User)).
+		Where("deletedAt IS NOT NULL").
+		Updates(map[string]any{
+			"deletedAt": nil,
+			// 恢复的账号不再处于待删除状态，否则会被再次删除
+			"deletionScheduledAt": nil,
+			"version":             gorm.Expr("version + 1"),
+		})
+	if result.Error != nil {
+		slog.ErrorContext(ctx, "Failed to restore users", "err", result.Error, "conditions", opts)
+		return 0, errorsx.ErrDBWrite.WithMessage("%s", result.Error.Error())
+	}
+
+	return result.RowsAffected, nil
+}
+
+// Purge 永久删除符合条件的用户记录.
+func (s *userStore) Purge(ctx context.Context, opts *where.Options) error {
+	err := s.store.DB(ctx, opts).Unscoped().Delete(new(model.User)).Error
+	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
+		slog.ErrorContext(ctx, "Failed to purge users from database", "err", err, "conditions", opts)
+		return errorsx.ErrDBWrite.WithMessage("%s", err.Error())
+	}
+
+	return nil
+}
@@ -7,6 +7,12 @@ type (
 	requestIDKey struct{}
 	// userIDKey 定义用户 ID 的上下文键.
 	userIDKey struct{}
+	// roleKey 定义用户角色的上下文键.
+	roleKey struct{}
+	// tokenIDKey 定义访问令牌 ID 的上下文键.
+	tokenIDKey struct{}
+	// sessionIDKey 定义登录会话 ID 的上下文键.
+	sessionIDKey struct{}
 )
 
 func WithRequestID(ctx context.Context, requestID string) context.Context {
@@ -29,3 +35,36 @@ func UserID(ctx context.Context) string {
 	userID, _ := ctx.Value(userIDKey{}).(string)
 	return userID
 }
+
+// WithRole 将用户角色存放到上下文中.
+func WithRole(ctx context.Context, role string) context.Context {
+	return context.WithValue(ctx, roleKey{}, role)
+}
+
+// Role 从上下文中提取用户角色.
+func Role(ctx context.Context) string {
+	role, _ := ctx.Value(roleKey{}).(string)
+	return role
+}
+
+// WithTokenID 将访问令牌 ID（jti）存放到上下文中.
+func WithTokenID(ctx context.Context, tokenID string) context.Context {
+	return context.WithValue(ctx, tokenIDKey{}, tokenID)
+}
+
+// TokenID 从上下文中提取访问令牌 ID（jti）.
+func TokenID(ctx context.Context) string {
+	tokenID, _ := ctx.Value(tokenIDKey{}).(string)
+	return tokenID
+}
+
+// WithSessionID 将登录会话 ID（sid）存放到上下文中.
+func WithSessionID(ctx context.Context, sessionID string) context.Context {
+	return context.WithValue(ctx, sessionIDKey{}, sessionID)
+}
+
+// SessionID 从上下文中提取登录会话 ID（sid）.
+func SessionID(ctx context.Context) string {
+	sessionID, _ := ctx.Value(sessionIDKey{}).(string)
+	return sessionID
+}
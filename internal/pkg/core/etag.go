@@ -0,0 +1,66 @@
+package core
+
+import (
+	"net/http"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+	"github.com/onexstack/fastgo/internal/pkg/errorsx"
+)
+
+// ETag 根据资源的版本号生成强校验 ETag.
+func ETag(version int64) string {
+	return `"` + strconv.FormatInt(version, 10) + `"`
+}
+
+// WriteResponseWithETag 返回带有 ETag 响应头的成功响应，version 为资源的版本号.
+// 请求头 If-None-Match 与 ETag 匹配时返回 304，不返回响应体.
+func WriteResponseWithETag(c *gin.Context, data any, version int64) {
+	etag := ETag(version)
+	c.Header("ETag", etag)
+	// 覆盖 NoCache 中间件设置的响应头：允许客户端缓存，但每次使用前都要通过 If-None-Match 重新校验
+	c.Header("Cache-Control", "private, no-cache")
+	c.Writer.Header().Del("Expires")
+	c.Writer.Header().Del("Last-Modified")
+
+	if matchETag(c.GetHeader("If-None-Match"), etag) {
+		c.Status(http.StatusNotModified)
+		return
+	}
+
+	c.JSON(http.StatusOK, data)
+}
+
+// IfMatchVersion 解析请求头 If-Match 中的版本号.
+// 请求头为空或为 "*" 时返回 nil，表示不校验版本号.
+func IfMatchVersion(c *gin.Context) (*int64, error) {
+	value := strings.TrimSpace(c.GetHeader("If-Match"))
+	if value == "" || value == "*" {
+		return nil, nil
+	}
+
+	// If-Match 使用强比较，弱 ETag 和多个 ETag 都不支持
+	unquoted, ok := strings.CutPrefix(value, `"`)
+	if ok {
+		unquoted, ok = strings.CutSuffix(unquoted, `"`)
+	}
+	version, err := strconv.ParseInt(unquoted, 10, 64)
+	if !ok || err != nil {
+		return nil, errorsx.New(errorsx.ErrInvalidArgument.Code, errorsx.ErrInvalidArgument.Reason, "invalid If-Match header %q", value)
+	}
+
+	return &version, nil
+}
+
+// matchETag 判断 If-None-Match 请求头是否与 etag 匹配，使用弱比较，忽略 W/ 前缀.
+func matchETag(header string, etag string) bool {
+	for _, candidate := range strings.Split(header, ",") {
+		candidate = strings.TrimSpace(candidate)
+		if candidate == "*" || strings.TrimPrefix(candidate, "W/") == etag {
+			return true
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,232 @@
+// Package diff 实现了按行比较文本差异的 Myers 差分算法.
+package diff
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+)
+
+// Op 表示一行文本在差异中的操作类型.
+type Op string
+
+const (
+	// OpEqual 表示该行在两个版本中都存在.
+	OpEqual Op = "equal"
+	// OpInsert 表示该行只存在于新版本中.
+	OpInsert Op = "insert"
+	// OpDelete 表示该行只存在于旧版本中.
+	OpDelete Op = "delete"
+)
+
+// Line 表示差异中的一行文本.
+type Line struct {
+	Op   Op     `json:"op"`
+	Text string `json:"text"`
+}
+
+// MaxLines 是参与逐行比较的最大行数. 去掉相同的首尾行后，两个版本剩余的行数之和超过该值时，
+// 不再计算最短编辑序列，直接将剩余部分视为全部删除后再全部插入，避免比较超大文本时耗尽 CPU.
+const MaxLines = 10000
+
+// Lines 按行比较 from 和 to，返回将 from 变为 to 的最短编辑序列.
+// 连续修改的行中，删除的行总是排在插入的行之前.
+func Lines(from, to string) []Line {
+	a, b := splitLines(from), splitLines(to)
+	d := &differ{lines: make([]Line, 0, max(len(a), len(b)))}
+
+	prefix, suffix := commonPrefix(a, b), 0
+	if prefix < min(len(a), len(b)) {
+		suffix = commonSuffix(a[prefix:], b[prefix:])
+	}
+	d.equal(a[:prefix])
+	if middleA, middleB := a[prefix:len(a)-suffix], b[prefix:len(b)-suffix]; len(middleA)+len(middleB) > MaxLines {
+		d.replace(middleA, middleB)
+	} else {
+		d.compare(middleA, middleB)
+	}
+	d.equal(a[len(a)-suffix:])
+
+	return groupChanges(d.lines)
+}
+
+// groupChanges 将每段连续修改的行重新排列为先删除后插入，不改变编辑序列的长度.
+func groupChanges(lines []Line) []Line {
+	for start := 0; start < len(lines); {
+		if lines[start].Op == OpEqual {
+			start++
+			continue
+		}
+
+		end := start
+		for end < len(lines) && lines[end].Op != OpEqual {
+			end++
+		}
+		slices.SortStableFunc(lines[start:end], func(x, y Line) int {
+			return cmp.Compare(opOrder(x.Op), opOrder(y.Op))
+		})
+		start = end
+	}
+
+	return lines
+}
+
+// opOrder 返回修改的行在连续修改中的顺序，删除排在插入之前.
+func opOrder(op Op) int {
+	if op == OpDelete {
+		return 0
+	}
+	return 1
+}
+
+// splitLines 将文本拆分为行，空文本没有任何行，末尾的换行符不会产生空行.
+func splitLines(text string) []string {
+	if text == "" {
+		return nil
+	}
+
+	return strings.Split(strings.TrimSuffix(text, "\n"), "\n")
+}
+
+// differ 保存比较过程中生成的编辑序列.
+type differ struct {
+	lines []Line
+}
+
+// compare 使用线性空间的 Myers 算法计算 a 到 b 的最短编辑序列.
+// 每次找到最短编辑路径的中间位置，将问题拆分为前后两个子问题递归求解，
+// 只需要 O(n+m) 的额外空间，不需要保存每一轮的搜索记录.
+func (d *differ) compare(a, b []string) {
+	prefix := commonPrefix(a, b)
+	d.equal(a[:prefix])
+	a, b = a[prefix:], b[prefix:]
+
+	suffix := commonSuffix(a, b)
+	tail := a[len(a)-suffix:]
+	a, b = a[:len(a)-suffix], b[:len(b)-suffix]
+
+	if len(a) == 0 || len(b) == 0 {
+		d.replace(a, b)
+	} else if x, y, ok := middle(a, b); ok {
+		d.compare(a[:x], b[:y])
+		d.compare(a[x:], b[y:])
+	} else {
+		d.replace(a, b)
+	}
+
+	d.equal(tail)
+}
+
+// middle 从起点和终点同时沿对角线搜索，返回两条路径相遇的位置.
+// 调用方保证 a 和 b 都不为空，并且首行和末行都不相同.
+func middle(a, b []string) (x, y int, ok bool) {
+	n, m := len(a), len(b)
+	maxD := (n + m + 1) / 2
+	offset := maxD
+	// forward[k] 和 backward[k] 分别记录从起点和终点出发，在对角线 k 上能到达的最远距离，-1 表示还未到达
+	forward, backward := make([]int, 2*maxD+2), make([]int, 2*maxD+2)
+	for i := range forward {
+		forward[i], backward[i] = -1, -1
+	}
+	forward[offset+1], backward[offset+1] = 0, 0
+
+	delta := n - m
+	// delta 为奇数时，两条路径在正向搜索中相遇，否则在反向搜索中相遇
+	odd := delta%2 != 0
+	// 路径超出编辑图边界的对角线不再搜索
+	fStart, fEnd, bStart, bEnd := 0, 0, 0, 0
+
+	for d := 0; d < maxD; d++ {
+		for k := -d + fStart; k <= d-fEnd; k += 2 {
+			i := offset + k
+			var fx int
+			if k == -d || (k != d && forward[i-1] < forward[i+1]) {
+				fx = forward[i+1]
+			} else {
+				fx = forward[i-1] + 1
+			}
+			fy := fx - k
+			for fx < n && fy < m && a[fx] == b[fy] {
+				fx++
+				fy++
+			}
+			forward[i] = fx
+
+			switch {
+			case fx > n:
+				fEnd += 2
+			case fy > m:
+				fStart += 2
+			case odd:
+				if j := offset + delta - k; j >= 0 && j < len(backward) && backward[j] != -1 && fx >= n-backward[j] {
+					return fx, fy, true
+				}
+			}
+		}
+
+		for k := -d + bStart; k <= d-bEnd; k += 2 {
+			i := offset + k
+			var bx int
+			if k == -d || (k != d && backward[i-1] < backward[i+1]) {
+				bx = backward[i+1]
+			} else {
+				bx = backward[i-1] + 1
+			}
+			by := bx - k
+			for bx < n && by < m && a[n-bx-1] == b[m-by-1] {
+				bx++
+				by++
+			}
+			backward[i] = bx
+
+			switch {
+			case bx > n:
+				bEnd += 2
+			case by > m:
+				bStart += 2
+			case !odd:
+				if j := offset + delta - k; j >= 0 && j < len(forward) && forward[j] != -1 && forward[j] >= n-bx {
+					fx := forward[j]
+					return fx, fx - (j - offset), true
+				}
+			}
+		}
+	}
+
+	return 0, 0, false
+}
+
+// equal 将 lines 作为相同的行追加到编辑序列.
+func (d *differ) equal(lines []string) {
+	for _, text := range lines {
+		d.lines = append(d.lines, Line{Op: OpEqual, Text: text})
+	}
+}
+
+// replace 将 a 全部删除后再插入 b.
+func (d *differ) replace(a, b []string) {
+	for _, text := range a {
+		d.lines = append(d.lines, Line{Op: OpDelete, Text: text})
+	}
+	for _, text := range b {
+		d.lines = append(d.lines, Line{Op: OpInsert, Text: text})
+	}
+}
+
+// commonPrefix 返回 a 和 b 开头相同的行数.
+func commonPrefix(a, b []string) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+	return i
+}
+
+// commonSuffix 返回 a 和 b 末尾相同的行数.
+func commonSuffix(a, b []string) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[len(a)-1-i] == b[len(b)-1-i] {
+		i++
+	}
+	return i
+}
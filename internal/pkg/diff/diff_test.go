@@ -0,0 +1,229 @@
+package diff
+
+import (
+	"fmt"
+	"math/rand"
+	"reflect"
+	"strconv"
+	"strings"
+	"testing"
+)
+
+func eq(text string) Line  { return Line{Op: OpEqual, Text: text} }
+func ins(text string) Line { return Line{Op: OpInsert, Text: text} }
+func del(text string) Line { return Line{Op: OpDelete, Text: text} }
+
+func TestLines(t *testing.T) {
+	tests := []struct {
+		name string
+		from string
+		to   string
+		want []Line
+	}{
+		{
+			name: "both empty",
+			want: []Line{},
+		},
+		{
+			name: "identical",
+			from: "a\nb\n",
+			to:   "a\nb",
+			want: []Line{eq("a"), eq("b")},
+		},
+		{
+			name: "from empty",
+			to:   "a\nb\n",
+			want: []Line{ins("a"), ins("b")},
+		},
+		{
+			name: "to empty",
+			from: "a\nb\n",
+			want: []Line{del("a"), del("b")},
+		},
+		{
+			name: "insert at start",
+			from: "b\nc",
+			to:   "a\nb\nc",
+			want: []Line{ins("a"), eq("b"), eq("c")},
+		},
+		{
+			name: "insert in middle",
+			from: "a\nc",
+			to:   "a\nb\nc",
+			want: []Line{eq("a"), ins("b"), eq("c")},
+		},
+		{
+			name: "insert at end",
+			from: "a\nb",
+			to:   "a\nb\nc",
+			want: []Line{eq("a"), eq("b"), ins("c")},
+		},
+		{
+			name: "delete at start",
+			from: "a\nb\nc",
+			to:   "b\nc",
+			want: []Line{del("a"), eq("b"), eq("c")},
+		},
+		{
+			name: "delete in middle",
+			from: "a\nb\nc",
+			to:   "a\nc",
+			want: []Line{eq("a"), del("b"), eq("c")},
+		},
+		{
+			name: "delete at end",
+			from: "a\nb\nc",
+			to:   "a\nb",
+			want: []Line{eq("a"), eq("b"), del("c")},
+		},
+		{
+			name: "single changed line",
+			from: "a\nb\nc",
+			to:   "a\nx\nc",
+			want: []Line{eq("a"), del("b"), ins("x"), eq("c")},
+		},
+		{
+			name: "adjacent changed lines",
+			from: "a\nb\nc\nd",
+			to:   "a\nx\ny\nd",
+			want: []Line{eq("a"), del("b"), del("c"), ins("x"), ins("y"), eq("d")},
+		},
+		{
+			name: "all lines changed",
+			from: "a\nb",
+			to:   "x\ny",
+			want: []Line{del("a"), del("b"), ins("x"), ins("y")},
+		},
+		{
+			name: "blank lines are compared",
+			from: "a\n\nb",
+			to:   "a\nb",
+			want: []Line{eq("a"), del(""), eq("b")},
+		},
+		{
+			name: "whitespace is significant",
+			from: "a\n b",
+			to:   "a\nb",
+			want: []Line{eq("a"), del(" b"), ins("b")},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := Lines(tt.from, tt.to)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("Lines(%q, %q) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+// TestLinesReconstruct 校验差异可以还原出两个版本，并且编辑序列是最短的.
+func TestLinesReconstruct(t *testing.T) {
+	tests := []struct {
+		from  string
+		to    string
+		edits int
+	}{
+		{from: "a\nb\nc\na\nb\nb\na", to: "c\nb\na\nb\na\nc", edits: 5},
+		{from: "x\na\nb\nc", to: "a\nb\nc\nx", edits: 2},
+		{from: "a\na\na", to: "a\na", edits: 1},
+		{from: "a\nb\na\nb", to: "b\na\nb\na", edits: 2},
+	}
+
+	for _, tt := range tests {
+		lines := Lines(tt.from, tt.to)
+
+		var from, to []string
+		edits := 0
+		for _, line := range lines {
+			switch line.Op {
+			case OpEqual:
+				from = append(from, line.Text)
+				to = append(to, line.Text)
+			case OpDelete:
+				from = append(from, line.Text)
+				edits++
+			case OpInsert:
+				to = append(to, line.Text)
+				edits++
+			}
+		}
+
+		if got := strings.Join(from, "\n"); got != tt.from {
+			t.Errorf("Lines(%q, %q) does not reconstruct from: got %q", tt.from, tt.to, got)
+		}
+		if got := strings.Join(to, "\n"); got != tt.to {
+			t.Errorf("Lines(%q, %q) does not reconstruct to: got %q", tt.from, tt.to, got)
+		}
+		if edits != tt.edits {
+			t.Errorf("Lines(%q, %q) has %d edits, want %d", tt.from, tt.to, edits, tt.edits)
+		}
+	}
+}
+
+// lcs 使用动态规划计算 a 和 b 的最长公共子序列长度.
+func lcs(a, b []string) int {
+	prev, cur := make([]int, len(b)+1), make([]int, len(b)+1)
+	for i := range a {
+		for j := range b {
+			if a[i] == b[j] {
+				cur[j+1] = prev[j] + 1
+			} else {
+				cur[j+1] = max(prev[j+1], cur[j])
+			}
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(b)]
+}
+
+// TestLinesMinimal 使用随机文本校验编辑序列的长度等于 n+m-2*LCS，并且修改的行先删除后插入.
+func TestLinesMinimal(t *testing.T) {
+	r := rand.New(rand.NewSource(1))
+	randomLines := func() []string {
+		lines := make([]string, r.Intn(30))
+		for i := range lines {
+			lines[i] = strconv.Itoa(r.Intn(4))
+		}
+		return lines
+	}
+
+	for i := 0; i < 500; i++ {
+		a, b := randomLines(), randomLines()
+		from, to := strings.Join(a, "\n"), strings.Join(b, "\n")
+
+		edits := 0
+		prev := OpEqual
+		for _, line := range Lines(from, to) {
+			if line.Op != OpEqual {
+				edits++
+			}
+			if prev == OpInsert && line.Op == OpDelete {
+				t.Fatalf("Lines(%q, %q) has a deletion after an insertion", from, to)
+			}
+			prev = line.Op
+		}
+
+		if want := len(a) + len(b) - 2*lcs(a, b); edits != want {
+			t.Fatalf("Lines(%q, %q) has %d edits, want %d", from, to, edits, want)
+		}
+	}
+}
+
+func TestLinesMaxLines(t *testing.T) {
+	var from, to strings.Builder
+	for i := 0; i < MaxLines; i++ {
+		fmt.Fprintf(&from, "a%d\n", i)
+		fmt.Fprintf(&to, "b%d\n", i)
+	}
+
+	// 首尾相同的行仍然按相同处理，中间超出限制的部分直接全部删除后插入
+	lines := Lines("head\n"+from.String()+"tail", "head\n"+to.String()+"tail")
+	if len(lines) != 2*MaxLines+2 {
+		t.Fatalf("len(Lines()) = %d, want %d", len(lines), 2*MaxLines+2)
+	}
+	if lines[0] != eq("head") || lines[1] != del("a0") || lines[MaxLines+1] != ins("b0") || lines[len(lines)-1] != eq("tail") {
+		t.Errorf("Lines() = %v ... %v, want the middle to be replaced", lines[:2], lines[len(lines)-1:])
+	}
+}
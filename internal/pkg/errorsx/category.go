@@ -0,0 +1,11 @@
+package errorsx
+
+import "net/http"
+
+var (
+	// ErrCategoryNotFound 表示未找到指定的分类.
+	ErrCategoryNotFound = &ErrorX{Code: http.StatusNotFound, Reason: "NotFound.CategoryNotFound", Message: "Category not found."}
+
+	// ErrCategoryNotEmpty 表示分类下仍有子分类或博客，不能删除.
+	ErrCategoryNotEmpty = &ErrorX{Code: http.StatusBadRequest, Reason: "FailedPrecondition.CategoryNotEmpty", Message: "Category still has subcategories or posts."}
+)
@@ -25,9 +25,27 @@ var (
 	// ErrInvalidArgument 表示参数验证失败.
 	ErrInvalidArgument = &ErrorX{Code: http.StatusBadRequest, Reason: "InvalidArgument", Message: "Argument verification failed."}
 
+	// ErrInvalidPageToken 表示分页令牌无效，或者与本次请求的排序方式和过滤条件不一致.
+	ErrInvalidPageToken = &ErrorX{Code: http.StatusBadRequest, Reason: "InvalidArgument.InvalidPageToken", Message: "Page token was invalid or does not match the request."}
+
 	// ErrSignToken 表示签发 JWT Token 时出错.
 	ErrSignToken = &ErrorX{Code: http.StatusUnauthorized, Reason: "Unauthenticated.SignToken", Message: "Error occurred while signing the JSON web token."}
 
 	// ErrTokenInvalid 表示 JWT Token 格式无效.
 	ErrTokenInvalid = &ErrorX{Code: http.StatusUnauthorized, Reason: "Unauthenticated.TokenInvalid", Message: "Token was invalid."}
+
+	// ErrTokenRevoked 表示 JWT Token 已被吊销，例如用户已经退出登录.
+	ErrTokenRevoked = &ErrorX{Code: http.StatusUnauthorized, Reason: "Unauthenticated.TokenRevoked", Message: "Token has been revoked."}
+
+	// ErrRefreshTokenInvalid 表示刷新令牌不存在、已过期或已被吊销.
+	ErrRefreshTokenInvalid = &ErrorX{Code: http.StatusUnauthorized, Reason: "Unauthenticated.RefreshTokenInvalid", Message: "Refresh token was invalid or expired."}
+
+	// ErrRefreshTokenReused 表示检测到已轮换的刷新令牌被重复使用，对应会话已被全部吊销.
+	ErrRefreshTokenReused = &ErrorX{Code: http.StatusUnauthorized, Reason: "Unauthenticated.RefreshTokenReused", Message: "Refresh token reuse detected, the session has been revoked."}
+
+	// ErrVersionConflict 表示资源在读取之后已被其他请求修改，客户端需要重新读取后再提交修改.
+	ErrVersionConflict = &ErrorX{Code: http.StatusPreconditionFailed, Reason: "FailedPrecondition.VersionConflict", Message: "Resource has been modified by another request."}
+
+	// ErrPermissionDenied 表示请求没有进行操作的权限.
+	ErrPermissionDenied = &ErrorX{Code: http.StatusForbidden, Reason: "PermissionDenied", Message: "Permission denied. Access to the requested resource is forbidden."}
 )
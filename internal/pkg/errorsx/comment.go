@@ -0,0 +1,14 @@
+package errorsx
+
+import "net/http"
+
+var (
+	// ErrCommentNotFound 表示未找到指定的评论.
+	ErrCommentNotFound = &ErrorX{Code: http.StatusNotFound, Reason: "NotFound.CommentNotFound", Message: "Comment not found."}
+
+	// ErrCommentEditWindowExpired 表示评论已超过允许编辑的时间.
+	ErrCommentEditWindowExpired = &ErrorX{Code: http.StatusForbidden, Reason: "PermissionDenied.CommentEditWindowExpired", Message: "Comment can no longer be edited."}
+
+	// ErrCommentDeleted 表示评论已被删除，不能编辑或回复.
+	ErrCommentDeleted = &ErrorX{Code: http.StatusBadRequest, Reason: "FailedPrecondition.CommentDeleted", Message: "Comment has been deleted."}
+)
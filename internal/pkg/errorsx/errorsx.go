@@ -44,6 +44,11 @@ func FromError(err error) *ErrorX {
 		return errx
 	}
 
+	// gRPC 客户端收到的错误是 gRPC 状态
+	if errx, ok := fromStatus(err); ok {
+		return errx
+	}
+
 	// 默认返回未知错误错误. 该错误代表服务端出错
 	return New(ErrInternal.Code, ErrInternal.Reason, err.Error())
 }
@@ -0,0 +1,72 @@
+package errorsx
+
+import (
+	"net/http"
+
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// grpcCodes 定义了 HTTP 状态码与 gRPC 状态码的对应关系.
+var grpcCodes = map[int]codes.Code{
+	http.StatusOK:                  codes.OK,
+	http.StatusBadRequest:          codes.InvalidArgument,
+	http.StatusUnauthorized:        codes.Unauthenticated,
+	http.StatusForbidden:           codes.PermissionDenied,
+	http.StatusNotFound:            codes.NotFound,
+	http.StatusConflict:            codes.AlreadyExists,
+	http.StatusPreconditionFailed:  codes.FailedPrecondition,
+	http.StatusTooManyRequests:     codes.ResourceExhausted,
+	http.StatusInternalServerError: codes.Internal,
+	http.StatusNotImplemented:      codes.Unimplemented,
+	http.StatusServiceUnavailable:  codes.Unavailable,
+	http.StatusGatewayTimeout:      codes.DeadlineExceeded,
+}
+
+// GRPCCode 返回 HTTP 状态码对应的 gRPC 状态码.
+func GRPCCode(code int) codes.Code {
+	if c, ok := grpcCodes[code]; ok {
+		return c
+	}
+	if code >= http.StatusInternalServerError {
+		return codes.Internal
+	}
+	return codes.Unknown
+}
+
+// HTTPCode 返回 gRPC 状态码对应的 HTTP 状态码.
+func HTTPCode(code codes.Code) int {
+	for httpCode, c := range grpcCodes {
+		if c == code {
+			return httpCode
+		}
+	}
+	return http.StatusInternalServerError
+}
+
+// GRPCStatus 将错误转换为 gRPC 状态，gRPC 服务端返回 ErrorX 时会自动使用该状态.
+// Reason 保存在 ErrorInfo 详情中，客户端可以通过 FromError 还原出 ErrorX.
+func (e *ErrorX) GRPCStatus() *status.Status {
+	s := status.New(GRPCCode(e.Code), e.Message)
+	if withDetails, err := s.WithDetails(&errdetails.ErrorInfo{Reason: e.Reason}); err == nil {
+		return withDetails
+	}
+	return s
+}
+
+// fromStatus 将 gRPC 状态还原为 ErrorX，ok 为 false 表示 err 不是 gRPC 状态错误.
+func fromStatus(err error) (*ErrorX, bool) {
+	s, ok := status.FromError(err)
+	if !ok {
+		return nil, false
+	}
+
+	errx := New(HTTPCode(s.Code()), s.Code().String(), "%s", s.Message())
+	for _, detail := range s.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok {
+			errx.Reason = info.Reason
+		}
+	}
+	return errx, true
+}
@@ -0,0 +1,11 @@
+package errorsx
+
+import "net/http"
+
+var (
+	// ErrPolicyNotFound 表示未找到指定的授权策略.
+	ErrPolicyNotFound = &ErrorX{Code: http.StatusNotFound, Reason: "NotFound.PolicyNotFound", Message: "Policy not found."}
+
+	// ErrPolicyAlreadyExists 表示授权策略已存在.
+	ErrPolicyAlreadyExists = &ErrorX{Code: http.StatusBadRequest, Reason: "AlreadyExist.PolicyAlreadyExists", Message: "Policy already exists."}
+)
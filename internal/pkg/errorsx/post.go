@@ -6,3 +6,9 @@ import (
 
 // ErrPostNotFound 表示未找到指定的博客.
 var ErrPostNotFound = &ErrorX{Code: http.StatusNotFound, Reason: "NotFound.PostNotFound", Message: "Post not found."}
+
+// ErrPostRevisionNotFound 表示未找到博客的指定修订版本.
+var ErrPostRevisionNotFound = &ErrorX{Code: http.StatusNotFound, Reason: "NotFound.PostRevisionNotFound", Message: "Post revision not found."}
+
+// ErrPostSearch 表示全文检索博客失败.
+var ErrPostSearch = &ErrorX{Code: http.StatusInternalServerError, Reason: "InternalError.PostSearch", Message: "Post search failure."}
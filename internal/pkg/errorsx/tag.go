@@ -0,0 +1,11 @@
+package errorsx
+
+import "net/http"
+
+var (
+	// ErrTagNotFound 表示未找到指定的标签.
+	ErrTagNotFound = &ErrorX{Code: http.StatusNotFound, Reason: "NotFound.TagNotFound", Message: "Tag not found."}
+
+	// ErrTagAlreadyExists 表示标签已存在.
+	ErrTagAlreadyExists = &ErrorX{Code: http.StatusBadRequest, Reason: "AlreadyExist.TagAlreadyExists", Message: "Tag already exists."}
+)
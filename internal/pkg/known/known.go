@@ -1,9 +1,91 @@
 package known
 
+import "time"
+
 const (
 	// XRequestID 用来定义上下文中的键，代表请求 ID.
 	XRequestID = "x-request-id"
 
 	// MaxErrGroupConcurrency 定义 errgroup 的最大并发数量
 	MaxErrGroupConcurrency = 10
+
+	// HomeDir 定义放置 fastgo 配置的默认目录（相对于用户主目录），fg-apiserver 和 fgctl 共用.
+	HomeDir = ".fastgo"
+)
+
+const (
+	// RoleAdmin 定义管理员角色，管理员可以管理所有用户和博客.
+	RoleAdmin = "admin"
+	// RoleUser 定义普通用户角色，普通用户只能管理自己的账号和博客.
+	RoleUser = "user"
+
+	// AdminUsername 定义初始管理员的默认用户名，参见 user.admin-username 配置.
+	AdminUsername = "root"
+)
+
+const (
+	// ModeDevelopment 定义开发模式，允许使用内置的默认 JWT 密钥等不安全的配置.
+	ModeDevelopment = "development"
+	// ModeProduction 定义生产模式，这是默认的运行模式.
+	ModeProduction = "production"
+)
+
+const (
+	// PostStatusDraft 定义草稿状态，草稿只有作者可见.
+	PostStatusDraft = "draft"
+	// PostStatusScheduled 定义定时发布状态，到达 publishAt 后由后台任务自动发布.
+	PostStatusScheduled = "scheduled"
+	// PostStatusPublished 定义已发布状态.
+	PostStatusPublished = "published"
+	// PostStatusArchived 定义已归档状态，归档的博文不再对外展示.
+	PostStatusArchived = "archived"
+)
+
+const (
+	// PostVisibilityPrivate 定义私有可见性，只有作者可以访问.
+	PostVisibilityPrivate = "private"
+	// PostVisibilityUnlisted 定义不公开列出的可见性，知道链接的用户可以访问，但不会出现在公开列表中.
+	PostVisibilityUnlisted = "unlisted"
+	// PostVisibilityPublic 定义公开可见性.
+	PostVisibilityPublic = "public"
+)
+
+const (
+	// DefaultPageSize 定义列表接口默认的每页数量.
+	DefaultPageSize = 20
+	// MaxPageSize 定义列表接口最大的每页数量.
+	MaxPageSize = 100
+	// MaxSearchQueryLength 定义全文检索关键词的最大长度.
+	MaxSearchQueryLength = 256
+	// MaxPostContentLength 定义博客内容的最大长度（字节）.
+	MaxPostContentLength = 1 << 20
+
+	// SortOrderAsc 定义正序排列.
+	SortOrderAsc = "asc"
+	// SortOrderDesc 定义倒序排列.
+	SortOrderDesc = "desc"
+)
+
+// TrashPurgeBatchSize 定义清理回收站时每批永久删除的记录数量，避免单个事务过大.
+const TrashPurgeBatchSize = 100
+
+const (
+	// TagModeAny 定义标签过滤方式：包含任意一个标签.
+	TagModeAny = "any"
+	// TagModeAll 定义标签过滤方式：包含所有标签.
+	TagModeAll = "all"
+)
+
+const (
+	// CommentEditWindow 定义评论创建后允许编辑的时间.
+	CommentEditWindow = 15 * time.Minute
+	// DeletedCommentPlaceholder 定义已删除评论的占位内容.
+	DeletedCommentPlaceholder = "[deleted]"
+)
+
+const (
+	// ExportFormatJSON 定义以 JSON 文件导出用户数据.
+	ExportFormatJSON = "json"
+	// ExportFormatZIP 定义以 ZIP 压缩包导出用户数据，压缩包中每篇博客单独保存为 Markdown 文件.
+	ExportFormatZIP = "zip"
 )
@@ -0,0 +1,95 @@
+// Package log 提供了 fg-apiserver 使用的 slog 扩展：从上下文中提取请求字段、按包设置日志级别、
+// 脱敏敏感字段，以及同时输出到多个目标.
+package log
+
+import (
+	"context"
+	"log/slog"
+	"sync/atomic"
+
+	"github.com/onexstack/fastgo/internal/pkg/contextx"
+	"github.com/onexstack/fastgo/internal/pkg/tracing"
+)
+
+// LevelVar 保存默认日志级别和按包覆盖的日志级别，可以在运行时并发安全地修改，
+// 修改后对所有使用它的 Handler 立即生效.
+type LevelVar struct {
+	level    slog.LevelVar
+	packages atomic.Pointer[PackageLevels]
+}
+
+// NewLevelVar 创建一个 LevelVar 实例.
+func NewLevelVar(level slog.Level, packages PackageLevels) *LevelVar {
+	v := &LevelVar{}
+	v.Set(level, packages)
+	return v
+}
+
+// Level 返回默认日志级别.
+func (v *LevelVar) Level() slog.Level {
+	return v.level.Level()
+}
+
+// PackageLevels 返回按包覆盖的日志级别，调用方不能修改返回值.
+func (v *LevelVar) PackageLevels() PackageLevels {
+	if p := v.packages.Load(); p != nil {
+		return *p
+	}
+	return nil
+}
+
+// Set 同时修改默认日志级别和按包覆盖的日志级别.
+func (v *LevelVar) Set(level slog.Level, packages PackageLevels) {
+	v.level.Set(level)
+	v.packages.Store(&packages)
+}
+
+// LevelAll 是被包装的 Handler 应该使用的日志级别，日志级别统一由 NewHandler 返回的 Handler 过滤.
+const LevelAll = slog.Level(-1 << 10)
+
+// handler 包装另一个 slog.Handler，负责按包过滤日志级别，并添加上下文中的请求字段.
+// 被包装的 Handler 不应再过滤日志级别，参见 LevelAll.
+type handler struct {
+	slog.Handler
+	levels *LevelVar
+}
+
+// NewHandler 包装 h，为使用 slog.InfoContext 等带上下文的方法输出的日志添加
+// 请求 ID、用户 ID、trace ID 和 span ID，并根据 levels 过滤日志级别.
+func NewHandler(h slog.Handler, levels *LevelVar) slog.Handler {
+	return &handler{Handler: h, levels: levels}
+}
+
+// Enabled 实现 slog.Handler 接口. 配置了包级别时，只能在 Handle 中根据调用位置判断是否输出.
+func (h *handler) Enabled(ctx context.Context, level slog.Level) bool {
+	return level >= h.levels.PackageLevels().min(h.levels.Level())
+}
+
+// Handle 实现 slog.Handler 接口.
+func (h *handler) Handle(ctx context.Context, r slog.Record) error {
+	if r.Level < h.levels.PackageLevels().levelFor(r.PC, h.levels.Level()) {
+		return nil
+	}
+
+	if requestID := contextx.RequestID(ctx); requestID != "" {
+		r.AddAttrs(slog.String("requestID", requestID))
+	}
+	if userID := contextx.UserID(ctx); userID != "" {
+		r.AddAttrs(slog.String("userID", userID))
+	}
+	if traceID := tracing.TraceID(ctx); traceID != "" {
+		r.AddAttrs(slog.String("traceID", traceID), slog.String("spanID", tracing.SpanID(ctx)))
+	}
+
+	return h.Handler.Handle(ctx, r)
+}
+
+// WithAttrs 实现 slog.Handler 接口.
+func (h *handler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &handler{Handler: h.Handler.WithAttrs(attrs), levels: h.levels}
+}
+
+// WithGroup 实现 slog.Handler 接口.
+func (h *handler) WithGroup(name string) slog.Handler {
+	return &handler{Handler: h.Handler.WithGroup(name), levels: h.levels}
+}
@@ -0,0 +1,94 @@
+package log
+
+import (
+	"fmt"
+	"log/slog"
+	"runtime"
+	"strings"
+	"sync"
+)
+
+// modulePath 是本项目的模块路径，PackageLevels 中的相对包路径基于该路径.
+const modulePath = "github.com/onexstack/fastgo/"
+
+// PackageLevels 按包覆盖日志级别. 键为包路径，同时作用于子包，例如 internal/apiserver/store；
+// 本项目的包可以省略模块路径. 一条日志匹配多个键时使用最长的键.
+type PackageLevels map[string]slog.Level
+
+// levelCache 缓存调用位置对应的包路径，避免每条日志都解析函数名.
+var levelCache sync.Map // map[uintptr]string
+
+// ParseLevel 解析 debug、info、warn、error 等日志级别，也支持 slog 的 "info+2" 格式.
+func ParseLevel(s string) (slog.Level, error) {
+	var level slog.Level
+	if err := level.UnmarshalText([]byte(s)); err != nil {
+		return 0, fmt.Errorf("invalid log level '%s'", s)
+	}
+	return level, nil
+}
+
+// ParsePackageLevels 将包路径到日志级别字符串的映射解析为 PackageLevels.
+func ParsePackageLevels(m map[string]string) (PackageLevels, error) {
+	levels := make(PackageLevels, len(m))
+	for pkg, s := range m {
+		level, err := ParseLevel(s)
+		if err != nil {
+			return nil, fmt.Errorf("package '%s': %w", pkg, err)
+		}
+		levels[normalizePackage(pkg)] = level
+	}
+	return levels, nil
+}
+
+// normalizePackage 为本项目的相对包路径补全模块路径.
+func normalizePackage(pkg string) string {
+	pkg = strings.Trim(pkg, "/")
+	first, _, _ := strings.Cut(pkg, "/")
+	if !strings.Contains(first, ".") {
+		return modulePath + pkg
+	}
+	return pkg
+}
+
+// min 返回默认级别和所有包级别中最低的级别.
+func (p PackageLevels) min(level slog.Level) slog.Level {
+	for _, l := range p {
+		level = min(level, l)
+	}
+	return level
+}
+
+// levelFor 返回调用位置 pc 所在包的日志级别，没有匹配的包时返回默认级别.
+func (p PackageLevels) levelFor(pc uintptr, level slog.Level) slog.Level {
+	if len(p) == 0 || pc == 0 {
+		return level
+	}
+
+	pkg := packageOf(pc)
+	matched := ""
+	for prefix, l := range p {
+		if (pkg == prefix || strings.HasPrefix(pkg, prefix+"/")) && len(prefix) > len(matched) {
+			matched, level = prefix, l
+		}
+	}
+	return level
+}
+
+// packageOf 返回调用位置 pc 所在函数的包路径.
+func packageOf(pc uintptr) string {
+	if pkg, ok := levelCache.Load(pc); ok {
+		return pkg.(string)
+	}
+
+	frame, _ := runtime.CallersFrames([]uintptr{pc}).Next()
+	// 函数名的格式为 path/to/pkg.(*Type).Method 或 path/to/pkg.Func.func1
+	name := frame.Function
+	slash := strings.LastIndex(name, "/")
+	pkg := name
+	if dot := strings.Index(name[slash+1:], "."); dot >= 0 {
+		pkg = name[:slash+1+dot]
+	}
+
+	levelCache.Store(pc, pkg)
+	return pkg
+}
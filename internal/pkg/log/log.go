@@ -0,0 +1,149 @@
+package log
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	"os"
+	"strings"
+	"sync"
+
+	"gopkg.in/natefinch/lumberjack.v2"
+
+	genericoptions "github.com/onexstack/fastgo/pkg/options"
+)
+
+var (
+	mu sync.Mutex
+	// levels 是全局日志配置的日志级别，Init 之前使用 info 级别.
+	levels = NewLevelVar(slog.LevelInfo, nil)
+	// closers 是 Init 打开的日志输出，Close 时关闭.
+	closers []io.Closer
+)
+
+// Init 根据 opts 创建日志输出，并设置为 slog 的默认 Logger. 重复调用时关闭上一次打开的日志输出.
+func Init(opts *genericoptions.LogOptions) error {
+	level, err := ParseLevel(opts.Level)
+	if err != nil {
+		return err
+	}
+	packages, err := ParsePackageLevels(opts.Levels)
+	if err != nil {
+		return err
+	}
+
+	// 日志级别统一由 NewHandler 过滤，敏感字段在输出前脱敏
+	handlerOpts := &slog.HandlerOptions{
+		Level:       LevelAll,
+		ReplaceAttr: Redact,
+	}
+
+	var handlers []slog.Handler
+	var opened []io.Closer
+	for _, output := range opts.Outputs {
+		var h slog.Handler
+		switch output {
+		case genericoptions.LogOutputConsole:
+			w := os.Stdout
+			if opts.Console.Stream == "stderr" {
+				w = os.Stderr
+			}
+			h = newFormatHandler(w, opts.Format, handlerOpts)
+		case genericoptions.LogOutputFile:
+			// 日志文件按大小轮转，并按数量和保留天数清理旧文件
+			w := &lumberjack.Logger{
+				Filename:   opts.File.Path,
+				MaxSize:    opts.File.MaxSize,
+				MaxAge:     opts.File.MaxAge,
+				MaxBackups: opts.File.MaxBackups,
+				Compress:   opts.File.Compress,
+				LocalTime:  true,
+			}
+			opened = append(opened, w)
+			h = newFormatHandler(w, opts.Format, handlerOpts)
+		case genericoptions.LogOutputSyslog:
+			sh, closer, err := newSyslogHandler(&opts.Syslog, opts.Format, handlerOpts)
+			if err != nil {
+				closeAll(opened)
+				return fmt.Errorf("failed to connect to syslog: %w", err)
+			}
+			opened = append(opened, closer)
+			h = sh
+		default:
+			closeAll(opened)
+			return fmt.Errorf("unknown log output '%s'", output)
+		}
+		handlers = append(handlers, h)
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+
+	levels.Set(level, packages)
+	slog.SetDefault(slog.New(NewHandler(newMultiHandler(handlers...), levels)))
+
+	previous := closers
+	closers = opened
+	return closeAll(previous)
+}
+
+// Close 关闭 Init 打开的日志输出，服务退出前调用.
+func Close() error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	err := closeAll(closers)
+	closers = nil
+	return err
+}
+
+// SetLevel 在运行时修改全局日志级别. level 为空时保留当前的全局日志级别，
+// packages 为 nil 时保留当前按包覆盖的日志级别.
+func SetLevel(level string, packages map[string]string) error {
+	mu.Lock()
+	defer mu.Unlock()
+
+	var err error
+	l := levels.Level()
+	if level != "" {
+		if l, err = ParseLevel(level); err != nil {
+			return err
+		}
+	}
+
+	current := levels.PackageLevels()
+	if packages != nil {
+		if current, err = ParsePackageLevels(packages); err != nil {
+			return err
+		}
+	}
+
+	levels.Set(l, current)
+	return nil
+}
+
+// GetLevel 返回当前的全局日志级别和按包覆盖的日志级别.
+func GetLevel() (string, map[string]string) {
+	packages := make(map[string]string)
+	for pkg, level := range levels.PackageLevels() {
+		packages[pkg] = strings.ToLower(level.String())
+	}
+	return strings.ToLower(levels.Level().String()), packages
+}
+
+// newFormatHandler 根据日志格式创建写入 w 的 Handler.
+func newFormatHandler(w io.Writer, format string, opts *slog.HandlerOptions) slog.Handler {
+	if format == genericoptions.LogFormatJSON {
+		return slog.NewJSONHandler(w, opts)
+	}
+	return slog.NewTextHandler(w, opts)
+}
+
+func closeAll(cs []io.Closer) error {
+	var errs []error
+	for _, c := range cs {
+		errs = append(errs, c.Close())
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,58 @@
+package log
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+)
+
+// multiHandler 将每条日志写入多个 Handler.
+type multiHandler []slog.Handler
+
+// newMultiHandler 创建写入所有 handlers 的 Handler，只有一个 Handler 时直接返回该 Handler.
+func newMultiHandler(handlers ...slog.Handler) slog.Handler {
+	if len(handlers) == 1 {
+		return handlers[0]
+	}
+	return multiHandler(handlers)
+}
+
+// Enabled 实现 slog.Handler 接口，任意一个 Handler 输出该级别的日志时返回 true.
+func (m multiHandler) Enabled(ctx context.Context, level slog.Level) bool {
+	for _, h := range m {
+		if h.Enabled(ctx, level) {
+			return true
+		}
+	}
+	return false
+}
+
+// Handle 实现 slog.Handler 接口. 某个输出失败时仍会写入其他输出，并返回所有错误.
+func (m multiHandler) Handle(ctx context.Context, r slog.Record) error {
+	var errs []error
+	for _, h := range m {
+		if h.Enabled(ctx, r.Level) {
+			// 每个 Handler 使用独立的记录副本，避免共享属性切片
+			errs = append(errs, h.Handle(ctx, r.Clone()))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// WithAttrs 实现 slog.Handler 接口.
+func (m multiHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	handlers := make(multiHandler, 0, len(m))
+	for _, h := range m {
+		handlers = append(handlers, h.WithAttrs(attrs))
+	}
+	return handlers
+}
+
+// WithGroup 实现 slog.Handler 接口.
+func (m multiHandler) WithGroup(name string) slog.Handler {
+	handlers := make(multiHandler, 0, len(m))
+	for _, h := range m {
+		handlers = append(handlers, h.WithGroup(name))
+	}
+	return handlers
+}
@@ -0,0 +1,83 @@
+package log
+
+import (
+	"log/slog"
+	"reflect"
+	"strings"
+)
+
+// redacted 是被脱敏字段的替换值.
+const redacted = "[REDACTED]"
+
+// sensitiveSuffixes 是需要脱敏的字段名后缀，比较时忽略大小写、下划线和连字符.
+// 例如 password、newPassword、refreshToken、client_secret 都会被脱敏，tokenID 不会.
+var sensitiveSuffixes = []string{"password", "token", "secret", "authorization", "cookie"}
+
+// IsSensitive 判断字段名是否为需要脱敏的敏感字段.
+func IsSensitive(key string) bool {
+	key = strings.ToLower(strings.NewReplacer("_", "", "-", "").Replace(key))
+	for _, suffix := range sensitiveSuffixes {
+		if strings.HasSuffix(key, suffix) {
+			return true
+		}
+	}
+	return false
+}
+
+// Redact 可以作为 slog.HandlerOptions.ReplaceAttr 使用，将敏感字段的值替换为 [REDACTED].
+// 日志中直接记录的结构体（例如 store 层记录的 model.User）也会检查导出字段，包含敏感字段时替换为脱敏后的分组.
+func Redact(groups []string, a slog.Attr) slog.Attr {
+	if IsSensitive(a.Key) {
+		return slog.String(a.Key, redacted)
+	}
+
+	if a.Value.Kind() == slog.KindAny {
+		if v, ok := redactStruct(reflect.ValueOf(a.Value.Any())); ok {
+			return slog.Attr{Key: a.Key, Value: v}
+		}
+	}
+
+	return a
+}
+
+// redactStruct 将包含敏感字段的结构体转换为脱敏后的 slog 分组，不包含敏感字段时返回 false，保持原有的输出格式.
+func redactStruct(v reflect.Value) (slog.Value, bool) {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return slog.Value{}, false
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct {
+		return slog.Value{}, false
+	}
+
+	var attrs []slog.Attr
+	found := false
+	t := v.Type()
+	for i := range t.NumField() {
+		field := t.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+
+		key := field.Name
+		if name, _, _ := strings.Cut(field.Tag.Get("json"), ","); name == "-" {
+			continue
+		} else if name != "" {
+			key = name
+		}
+
+		if IsSensitive(key) || IsSensitive(field.Name) {
+			found = true
+			attrs = append(attrs, slog.String(key, redacted))
+			continue
+		}
+		attrs = append(attrs, slog.Any(key, v.Field(i).Interface()))
+	}
+
+	if !found {
+		return slog.Value{}, false
+	}
+	return slog.GroupValue(attrs...), true
+}
@@ -0,0 +1,72 @@
+//go:build !windows && !plan9
+
+package log
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"log/slog"
+	"log/syslog"
+	"sync"
+
+	genericoptions "github.com/onexstack/fastgo/pkg/options"
+)
+
+// syslogHandler 将日志格式化后按照日志级别写入 syslog 对应的优先级.
+type syslogHandler struct {
+	slog.Handler
+	// out 在同一个 syslogHandler 派生出的所有 Handler 之间共享
+	out *syslogOutput
+}
+
+// syslogOutput 保存格式化日志使用的缓冲区和 syslog 连接.
+type syslogOutput struct {
+	mu     sync.Mutex
+	buf    bytes.Buffer
+	writer *syslog.Writer
+}
+
+// newSyslogHandler 连接 syslog 服务并创建 Handler，返回的 io.Closer 用于关闭连接.
+func newSyslogHandler(opts *genericoptions.LogSyslogOptions, format string, handlerOpts *slog.HandlerOptions) (slog.Handler, io.Closer, error) {
+	writer, err := syslog.Dial(opts.Network, opts.Addr, syslog.LOG_INFO|syslog.LOG_DAEMON, opts.Tag)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	out := &syslogOutput{writer: writer}
+	return &syslogHandler{Handler: newFormatHandler(&out.buf, format, handlerOpts), out: out}, writer, nil
+}
+
+// Handle 实现 slog.Handler 接口. 先将日志格式化到缓冲区，再按照日志级别写入 syslog.
+func (h *syslogHandler) Handle(ctx context.Context, r slog.Record) error {
+	h.out.mu.Lock()
+	defer h.out.mu.Unlock()
+
+	h.out.buf.Reset()
+	if err := h.Handler.Handle(ctx, r); err != nil {
+		return err
+	}
+
+	msg := h.out.buf.String()
+	switch {
+	case r.Level >= slog.LevelError:
+		return h.out.writer.Err(msg)
+	case r.Level >= slog.LevelWarn:
+		return h.out.writer.Warning(msg)
+	case r.Level >= slog.LevelInfo:
+		return h.out.writer.Info(msg)
+	default:
+		return h.out.writer.Debug(msg)
+	}
+}
+
+// WithAttrs 实现 slog.Handler 接口.
+func (h *syslogHandler) WithAttrs(attrs []slog.Attr) slog.Handler {
+	return &syslogHandler{Handler: h.Handler.WithAttrs(attrs), out: h.out}
+}
+
+// WithGroup 实现 slog.Handler 接口.
+func (h *syslogHandler) WithGroup(name string) slog.Handler {
+	return &syslogHandler{Handler: h.Handler.WithGroup(name), out: h.out}
+}
@@ -0,0 +1,16 @@
+//go:build windows || plan9
+
+package log
+
+import (
+	"errors"
+	"io"
+	"log/slog"
+
+	genericoptions "github.com/onexstack/fastgo/pkg/options"
+)
+
+// newSyslogHandler 在不支持 syslog 的平台上总是返回错误.
+func newSyslogHandler(opts *genericoptions.LogSyslogOptions, format string, handlerOpts *slog.HandlerOptions) (slog.Handler, io.Closer, error) {
+	return nil, nil, errors.New("syslog is not supported on this platform")
+}
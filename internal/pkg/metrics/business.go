@@ -0,0 +1,54 @@
+package metrics
+
+import "github.com/prometheus/client_golang/prometheus"
+
+var (
+	loginsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "logins_total",
+		Help:      "Total number of login attempts by result (success or failure).",
+	}, []string{"result"})
+
+	usersCreatedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "users_created_total",
+		Help:      "Total number of users created.",
+	})
+
+	postsCreatedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "posts_created_total",
+		Help:      "Total number of posts created.",
+	})
+
+	postsPublishedTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Namespace: namespace,
+		Name:      "posts_published_total",
+		Help:      "Total number of posts published, including scheduled posts published by the background task.",
+	})
+)
+
+// LoginSucceeded 记录一次成功的登录.
+func LoginSucceeded() {
+	loginsTotal.WithLabelValues("success").Inc()
+}
+
+// LoginFailed 记录一次失败的登录，用户名不存在和密码错误都算作失败.
+func LoginFailed() {
+	loginsTotal.WithLabelValues("failure").Inc()
+}
+
+// UserCreated 记录新创建的用户.
+func UserCreated() {
+	usersCreatedTotal.Inc()
+}
+
+// PostCreated 记录新创建的博客.
+func PostCreated() {
+	postsCreatedTotal.Inc()
+}
+
+// PostsPublished 记录新发布的博客数量.
+func PostsPublished(count int64) {
+	postsPublishedTotal.Add(float64(count))
+}
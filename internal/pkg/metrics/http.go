@@ -0,0 +1,33 @@
+package metrics
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+)
+
+var (
+	httpRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "requests_total",
+		Help:      "Total number of HTTP requests by route template, method and status code.",
+	}, []string{"route", "method", "code"})
+
+	httpRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "http",
+		Name:      "request_duration_seconds",
+		Help:      "HTTP request latency in seconds by route template, method and status code.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"route", "method", "code"})
+)
+
+// ObserveHTTPRequest 记录一次 HTTP 请求.
+// route 必须是路由模板（例如 /v1/posts/:postID），不能是实际的请求路径，否则指标的基数会无限增长.
+func ObserveHTTPRequest(route, method string, code int, elapsed time.Duration) {
+	codeStr := strconv.Itoa(code)
+	httpRequestsTotal.WithLabelValues(route, method, codeStr).Inc()
+	httpRequestDuration.WithLabelValues(route, method, codeStr).Observe(elapsed.Seconds())
+}
@@ -0,0 +1,37 @@
+// Package metrics 定义了 fg-apiserver 暴露的 Prometheus 指标.
+// 所有指标注册到包内独立的 Registry 中，通过 Handler 以 Prometheus 文本格式输出.
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// namespace 是所有指标名称的前缀.
+const namespace = "fastgo"
+
+// Registry 保存了 fg-apiserver 的所有指标，包括 Go 运行时和进程指标.
+var Registry = prometheus.NewRegistry()
+
+func init() {
+	Registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		httpRequestsTotal,
+		httpRequestDuration,
+		dbQueryDuration,
+		dbQueryErrorsTotal,
+		loginsTotal,
+		usersCreatedTotal,
+		postsCreatedTotal,
+		postsPublishedTotal,
+	)
+}
+
+// Handler 返回输出所有指标的 HTTP 处理器.
+func Handler() http.Handler {
+	return promhttp.HandlerFor(Registry, promhttp.HandlerOpts{Registry: Registry})
+}
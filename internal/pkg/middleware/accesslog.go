@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"log/slog"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// AccessLog 在请求处理完成后输出一条访问日志，包含状态码、耗时、响应大小和客户端 IP.
+// 5xx 响应使用 ERROR 级别，4xx 响应使用 WARN 级别，其他响应使用 INFO 级别.
+func AccessLog() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		status := c.Writer.Status()
+		level := slog.LevelInfo
+		switch {
+		case status >= http.StatusInternalServerError:
+			level = slog.LevelError
+		case status >= http.StatusBadRequest:
+			level = slog.LevelWarn
+		}
+
+		// 使用处理完成后的请求上下文，其中包含 Authn 中间件解析出的用户 ID
+		slog.LogAttrs(c.Request.Context(), level, "HTTP request",
+			slog.String("method", c.Request.Method),
+			slog.String("path", c.Request.URL.Path),
+			slog.String("route", c.FullPath()),
+			slog.Int("status", status),
+			slog.Duration("latency", time.Since(start)),
+			slog.Int("bytes", max(c.Writer.Size(), 0)),
+			slog.String("clientIP", c.ClientIP()),
+			slog.String("userAgent", c.Request.UserAgent()),
+		)
+	}
+}
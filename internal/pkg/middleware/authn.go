@@ -1,6 +1,8 @@
 package middleware
 
 import (
+	"errors"
+
 	"github.com/gin-gonic/gin"
 	"github.com/onexstack/fastgo/internal/pkg/contextx"
 	"github.com/onexstack/fastgo/internal/pkg/core"
@@ -10,15 +12,21 @@ import (
 
 func Authn() gin.HandlerFunc {
 	return func(c *gin.Context) {
-		userID, err := token.ParseRequest(c)
+		claims, err := token.ParseRequest(c)
 		if err != nil {
-			core.WriteResponse(c, nil, errorsx.ErrTokenInvalid)
+			if errors.Is(err, token.ErrTokenRevoked) {
+				core.WriteResponse(c, nil, errorsx.ErrTokenRevoked)
+			} else {
+				core.WriteResponse(c, nil, errorsx.ErrTokenInvalid)
+			}
 			c.Abort()
 			return
 		}
 
-		// 将用户ID和用户名注入到上下文中
-		ctx := contextx.WithUserID(c.Request.Context(), userID)
+		// 将用户ID、令牌 ID 和会话 ID 注入到上下文中
+		ctx := contextx.WithUserID(c.Request.Context(), claims.Identity)
+		ctx = contextx.WithTokenID(ctx, claims.ID)
+		ctx = contextx.WithSessionID(ctx, claims.SessionID)
 		c.Request = c.Request.WithContext(ctx)
 
 		c.Next()
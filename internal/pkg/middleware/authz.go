@@ -0,0 +1,34 @@
+package middleware
+
+import (
+	"context"
+
+	"github.com/gin-gonic/gin"
+	"github.com/onexstack/fastgo/internal/pkg/contextx"
+	"github.com/onexstack/fastgo/internal/pkg/core"
+)
+
+// Authorizer 定义了授权器需要实现的方法.
+type Authorizer interface {
+	// Authorize 判断用户是否有权限通过 method 访问 path 路由，有权限时返回用户的角色.
+	Authorize(ctx context.Context, userID string, path string, method string) (string, error)
+}
+
+// Authz 是授权中间件，需要在 Authn 之后执行.
+func Authz(a Authorizer) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		// 使用路由模板（例如 /v1/users/:userID）而不是实际请求路径进行授权
+		role, err := a.Authorize(c.Request.Context(), contextx.UserID(c.Request.Context()), c.FullPath(), c.Request.Method)
+		if err != nil {
+			core.WriteResponse(c, nil, err)
+			c.Abort()
+			return
+		}
+
+		// 将用户角色注入到上下文中，供 Biz 层判断数据访问范围
+		ctx := contextx.WithRole(c.Request.Context(), role)
+		c.Request = c.Request.WithContext(ctx)
+
+		c.Next()
+	}
+}
@@ -0,0 +1,44 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+)
+
+// AccessLog 是访问日志拦截器，与 REST API 的 AccessLog 中间件相同，在调用完成后输出一条访问日志.
+// 放在 Authn 之前，认证失败的调用也会被记录. 拦截器无法拿到后续拦截器修改后的上下文，所以访问日志中没有用户 ID.
+func AccessLog() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		start := time.Now()
+
+		resp, err := handler(ctx, req)
+
+		s, _ := status.FromError(err)
+		level := slog.LevelInfo
+		if err != nil {
+			level = slog.LevelWarn
+			if isServerError(s.Code()) {
+				level = slog.LevelError
+			}
+		}
+
+		var clientIP string
+		if p, ok := peer.FromContext(ctx); ok {
+			clientIP = p.Addr.String()
+		}
+
+		slog.LogAttrs(ctx, level, "gRPC request",
+			slog.String("method", info.FullMethod),
+			slog.String("code", s.Code().String()),
+			slog.Duration("latency", time.Since(start)),
+			slog.String("clientIP", clientIP),
+		)
+
+		return resp, err
+	}
+}
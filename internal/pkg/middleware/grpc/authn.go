@@ -0,0 +1,49 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/onexstack/fastgo/internal/pkg/contextx"
+	"github.com/onexstack/fastgo/internal/pkg/errorsx"
+	"github.com/onexstack/fastgo/pkg/token"
+)
+
+// Authn 是认证拦截器，从 authorization 元数据中解析 Bearer token.
+// publicMethods 中的方法（例如登录）无需认证，方法名为完整的 gRPC 方法名.
+func Authn(publicMethods ...string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if slices.Contains(publicMethods, info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		var header string
+		if values := metadata.ValueFromIncomingContext(ctx, "authorization"); len(values) > 0 {
+			header = values[0]
+		}
+		tokenString, ok := strings.CutPrefix(header, "Bearer ")
+		if !ok || tokenString == "" {
+			return nil, errorsx.ErrTokenInvalid
+		}
+
+		claims, err := token.Parse(ctx, tokenString)
+		if err != nil {
+			if errors.Is(err, token.ErrTokenRevoked) {
+				return nil, errorsx.ErrTokenRevoked
+			}
+			return nil, errorsx.ErrTokenInvalid
+		}
+
+		// 将用户ID、令牌 ID 和会话 ID 注入到上下文中
+		ctx = contextx.WithUserID(ctx, claims.Identity)
+		ctx = contextx.WithTokenID(ctx, claims.ID)
+		ctx = contextx.WithSessionID(ctx, claims.SessionID)
+
+		return handler(ctx, req)
+	}
+}
@@ -0,0 +1,44 @@
+package grpc
+
+import (
+	"context"
+	"slices"
+
+	"google.golang.org/grpc"
+
+	"github.com/onexstack/fastgo/internal/pkg/contextx"
+	"github.com/onexstack/fastgo/internal/pkg/errorsx"
+	mw "github.com/onexstack/fastgo/internal/pkg/middleware"
+)
+
+// Route 表示 gRPC 方法对应的 REST 路由.
+type Route struct {
+	// Method 是 HTTP 方法.
+	Method string
+	// Path 是路由模板，例如 /v1/users/:userID.
+	Path string
+}
+
+// Authz 是授权拦截器，需要在 Authn 之后执行.
+// 授权策略是按照 REST 路由配置的，gRPC 方法使用 routes 中对应的路由进行授权，
+// 这样同一个操作无论通过哪种协议调用，都遵循相同的策略. 不在 routes 中的非公开方法一律拒绝访问.
+func Authz(a mw.Authorizer, routes map[string]Route, publicMethods ...string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		if slices.Contains(publicMethods, info.FullMethod) {
+			return handler(ctx, req)
+		}
+
+		route, ok := routes[info.FullMethod]
+		if !ok {
+			return nil, errorsx.ErrPermissionDenied
+		}
+
+		role, err := a.Authorize(ctx, contextx.UserID(ctx), route.Path, route.Method)
+		if err != nil {
+			return nil, err
+		}
+
+		// 将用户角色注入到上下文中，供 Biz 层判断数据访问范围
+		return handler(contextx.WithRole(ctx, role), req)
+	}
+}
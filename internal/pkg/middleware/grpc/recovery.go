@@ -0,0 +1,30 @@
+package grpc
+
+import (
+	"context"
+	"log/slog"
+	"runtime/debug"
+
+	"google.golang.org/grpc"
+
+	"github.com/onexstack/fastgo/internal/pkg/errorsx"
+)
+
+// Recovery 是 panic 恢复拦截器，作用与 gin.Recovery 中间件相同，避免单个请求导致整个服务退出.
+// 它同时将返回的错误统一转换为 ErrorX，非 ErrorX 错误会作为内部错误返回，与 REST API 的错误响应保持一致.
+func Recovery() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (resp any, err error) {
+		defer func() {
+			if r := recover(); r != nil {
+				slog.ErrorContext(ctx, "Recovered from panic", "method", info.FullMethod, "panic", r, "stack", string(debug.Stack()))
+				resp, err = nil, errorsx.ErrInternal
+			}
+		}()
+
+		resp, err = handler(ctx, req)
+		if err != nil {
+			return nil, errorsx.FromError(err)
+		}
+		return resp, nil
+	}
+}
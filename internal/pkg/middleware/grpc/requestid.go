@@ -0,0 +1,31 @@
+package grpc
+
+import (
+	"context"
+
+	"github.com/google/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/onexstack/fastgo/internal/pkg/contextx"
+	"github.com/onexstack/fastgo/internal/pkg/known"
+)
+
+// RequestID 是请求 ID 拦截器，与 REST API 的 RequestID 中间件相同，优先使用客户端传入的请求 ID.
+func RequestID() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		// 从 `x-request-id` 元数据中获取请求 ID，如果不存在则生成新的 UUID
+		var requestID string
+		if values := metadata.ValueFromIncomingContext(ctx, known.XRequestID); len(values) > 0 {
+			requestID = values[0]
+		}
+		if requestID == "" {
+			requestID = uuid.New().String()
+		}
+
+		// 将请求 ID 通过响应头元数据返回给客户端
+		_ = grpc.SetHeader(ctx, metadata.Pairs(known.XRequestID, requestID))
+
+		return handler(contextx.WithRequestID(ctx, requestID), req)
+	}
+}
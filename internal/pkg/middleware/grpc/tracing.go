@@ -0,0 +1,76 @@
+package grpc
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	grpccodes "google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	"github.com/onexstack/fastgo/internal/pkg/tracing"
+)
+
+// Tracing 是链路追踪拦截器，与 REST API 的 Tracing 中间件相同，从 traceparent 元数据中恢复上游的链路.
+func Tracing() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req any, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (any, error) {
+		md, _ := metadata.FromIncomingContext(ctx)
+		ctx = otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+
+		ctx, span := tracing.Start(ctx, info.FullMethod,
+			trace.WithSpanKind(trace.SpanKindServer),
+			trace.WithAttributes(
+				attribute.String("rpc.system", "grpc"),
+				attribute.String("rpc.method", info.FullMethod),
+			),
+		)
+		defer span.End()
+
+		resp, err := handler(ctx, req)
+
+		// 客户端错误不标记为 span 错误，只记录 gRPC 状态码
+		s, _ := status.FromError(err)
+		span.SetAttributes(attribute.Int("rpc.grpc.status_code", int(s.Code())))
+		if err != nil && isServerError(s.Code()) {
+			span.SetStatus(codes.Error, s.Message())
+		}
+
+		return resp, err
+	}
+}
+
+// isServerError 判断 gRPC 状态码是否表示服务端错误.
+func isServerError(code grpccodes.Code) bool {
+	switch code {
+	case grpccodes.Unknown, grpccodes.Internal, grpccodes.Unavailable, grpccodes.DataLoss, grpccodes.DeadlineExceeded, grpccodes.Unimplemented:
+		return true
+	default:
+		return false
+	}
+}
+
+// metadataCarrier 让 gRPC 元数据可以作为 propagation.TextMapCarrier 使用.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	if values := metadata.MD(c).Get(key); len(values) > 0 {
+		return values[0]
+	}
+	return ""
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
@@ -8,6 +8,7 @@ import (
 )
 
 // NoCache 是一个 Gin 中间件，用来禁止客户端缓存 HTTP 请求的返回结果.
+// 返回 ETag 的接口（见 core.WriteResponseWithETag）会覆盖这里设置的响应头，允许客户端缓存并通过 If-None-Match 重新校验.
 func NoCache(c *gin.Context) {
 	c.Header("Cache-Control", "no-cache, no-store, max-age=0, must-revalidate, value")
 	c.Header("Expires", "Thu, 01 Jan 1970 00:00:00 GMT")
@@ -23,7 +24,8 @@ func Cors(c *gin.Context) {
 
 	c.Header("Access-Control-Allow-Origin", "*")
 	c.Header("Access-Control-Allow-Methods", "GET,POST,PUT,PATCH,DELETE,OPTIONS")
-	c.Header("Access-Control-Allow-Headers", "authorization, origin, content-type, accept")
+	c.Header("Access-Control-Allow-Headers", "authorization, origin, content-type, accept, if-match, if-none-match")
+	c.Header("Access-Control-Expose-Headers", "etag")
 	c.Header("Allow", "HEAD,GET,POST,PUT,PATCH,DELETE,OPTIONS")
 	c.Header("Content-Type", "application/json")
 	c.AbortWithStatus(http.StatusOK)
@@ -0,0 +1,27 @@
+package middleware
+
+import (
+	"time"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/onexstack/fastgo/internal/pkg/metrics"
+)
+
+// unmatchedRoute 是未匹配任何路由的请求使用的 route 标签值.
+const unmatchedRoute = "unmatched"
+
+// Metrics 记录每个请求的数量和耗时，按照路由模板、HTTP 方法和状态码分类.
+func Metrics() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		start := time.Now()
+		c.Next()
+
+		// 使用路由模板而不是实际的请求路径，避免指标的基数随路径参数无限增长
+		route := c.FullPath()
+		if route == "" {
+			route = unmatchedRoute
+		}
+		metrics.ObserveHTTPRequest(route, c.Request.Method, c.Writer.Status(), time.Since(start))
+	}
+}
@@ -0,0 +1,86 @@
+// Package openapi 根据路由描述和 Go 结构体生成 OpenAPI 3 文档，并提供内嵌的 Swagger UI.
+//
+// 请求和响应的 Schema 通过反射 Go 结构体生成，字段名取自 json 标签. 请求结构体中带有 uri 标签的字段
+// 是路径参数，带有 form 标签的字段是查询参数，其余 json 字段是请求体，与 gin 的绑定方式一致.
+package openapi // import "github.com/onexstack/fastgo/internal/pkg/openapi"
+
+// Version 是生成的文档遵循的 OpenAPI 规范版本.
+const Version = "3.0.3"
+
+// Document 表示一个 OpenAPI 文档.
+type Document struct {
+	OpenAPI    string              `json:"openapi"`
+	Info       Info                `json:"info"`
+	Paths      map[string]PathItem `json:"paths"`
+	Components Components          `json:"components"`
+}
+
+// Info 表示 API 的基本信息.
+type Info struct {
+	Title       string `json:"title"`
+	Description string `json:"description,omitempty"`
+	Version     string `json:"version"`
+}
+
+// PathItem 表示一个路径上的所有操作，键为小写的 HTTP 方法.
+type PathItem map[string]*Operation
+
+// Operation 表示一个 API 操作.
+type Operation struct {
+	OperationID string                `json:"operationId"`
+	Summary     string                `json:"summary,omitempty"`
+	Tags        []string              `json:"tags,omitempty"`
+	Parameters  []*Parameter          `json:"parameters,omitempty"`
+	RequestBody *RequestBody          `json:"requestBody,omitempty"`
+	Responses   map[string]*Response  `json:"responses"`
+	Security    []map[string][]string `json:"security,omitempty"`
+}
+
+// Parameter 表示路径参数或查询参数.
+type Parameter struct {
+	Name     string  `json:"name"`
+	In       string  `json:"in"`
+	Required bool    `json:"required,omitempty"`
+	Schema   *Schema `json:"schema"`
+}
+
+// RequestBody 表示请求体.
+type RequestBody struct {
+	Required bool                  `json:"required,omitempty"`
+	Content  map[string]*MediaType `json:"content"`
+}
+
+// Response 表示一种响应.
+type Response struct {
+	Description string                `json:"description"`
+	Content     map[string]*MediaType `json:"content,omitempty"`
+}
+
+// MediaType 表示某种内容类型的请求体或响应体.
+type MediaType struct {
+	Schema *Schema `json:"schema,omitempty"`
+}
+
+// Schema 表示数据结构，只包含生成文档需要的字段.
+type Schema struct {
+	Ref                  string             `json:"$ref,omitempty"`
+	Type                 string             `json:"type,omitempty"`
+	Format               string             `json:"format,omitempty"`
+	Nullable             bool               `json:"nullable,omitempty"`
+	Items                *Schema            `json:"items,omitempty"`
+	Properties           map[string]*Schema `json:"properties,omitempty"`
+	AdditionalProperties *Schema            `json:"additionalProperties,omitempty"`
+}
+
+// Components 表示文档中可复用的定义.
+type Components struct {
+	Schemas         map[string]*Schema         `json:"schemas,omitempty"`
+	SecuritySchemes map[string]*SecurityScheme `json:"securitySchemes,omitempty"`
+}
+
+// SecurityScheme 表示认证方式.
+type SecurityScheme struct {
+	Type         string `json:"type"`
+	Scheme       string `json:"scheme,omitempty"`
+	BearerFormat string `json:"bearerFormat,omitempty"`
+}
@@ -0,0 +1,170 @@
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+	"reflect"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/gin-gonic/gin"
+)
+
+// bearerAuth 是 JWT 认证方式在 components.securitySchemes 中的名称.
+const bearerAuth = "bearerAuth"
+
+// ginParamRegexp 用于匹配 gin 路由中的 :name 和 *name 参数.
+var ginParamRegexp = regexp.MustCompile(`[:*]([A-Za-z0-9_]+)`)
+
+// Route 描述一个已注册的路由，用于生成对应的 OpenAPI 操作.
+type Route struct {
+	// Method 是 HTTP 方法.
+	Method string
+	// Path 是 gin 格式的路由路径，例如 /v1/posts/:postID.
+	Path string
+	// OperationID 是操作的唯一标识，通常与处理函数同名.
+	OperationID string
+	// Summary 是操作的简要说明.
+	Summary string
+	// Tag 是操作所属的分组.
+	Tag string
+	// Auth 表示是否需要在 Authorization 请求头中携带 Bearer token.
+	Auth bool
+	// Request 是请求结构体的零值，为空表示没有请求参数.
+	Request any
+	// Response 是成功响应结构体的零值，为空表示响应结构不固定.
+	Response any
+	// ContentType 是成功响应的内容类型，为空时为 application/json.
+	ContentType string
+}
+
+// Build 根据路由描述生成 OpenAPI 文档，所有操作的错误响应都使用 errorResponse 的结构.
+func Build(info Info, errorResponse any, routes []Route) *Document {
+	s := &schemas{components: map[string]*Schema{}}
+	errorSchema := s.of(reflect.TypeOf(errorResponse))
+
+	doc := &Document{
+		OpenAPI: Version,
+		Info:    info,
+		Paths:   map[string]PathItem{},
+		Components: Components{
+			Schemas: s.components,
+			SecuritySchemes: map[string]*SecurityScheme{
+				bearerAuth: {Type: "http", Scheme: "bearer", BearerFormat: "JWT"},
+			},
+		},
+	}
+
+	for _, route := range routes {
+		path := specPath(route.Path)
+		if doc.Paths[path] == nil {
+			doc.Paths[path] = PathItem{}
+		}
+		doc.Paths[path][strings.ToLower(route.Method)] = s.operation(route, errorSchema)
+	}
+
+	return doc
+}
+
+// operation 生成路由对应的 OpenAPI 操作.
+func (s *schemas) operation(route Route, errorSchema *Schema) *Operation {
+	op := &Operation{
+		OperationID: route.OperationID,
+		Summary:     route.Summary,
+		Responses: map[string]*Response{
+			"default": {
+				Description: "错误响应",
+				Content:     map[string]*MediaType{"application/json": {Schema: errorSchema}},
+			},
+		},
+	}
+	if route.Tag != "" {
+		op.Tags = []string{route.Tag}
+	}
+	if route.Auth {
+		op.Security = []map[string][]string{{bearerAuth: {}}}
+	}
+
+	// 路径中的参数都是必填的，请求结构体中有对应的 uri 字段时使用字段的类型
+	pathParams := map[string]*Parameter{}
+	for _, match := range ginParamRegexp.FindAllStringSubmatch(route.Path, -1) {
+		param := &Parameter{Name: match[1], In: "path", Required: true, Schema: &Schema{Type: "string"}}
+		pathParams[param.Name] = param
+		op.Parameters = append(op.Parameters, param)
+	}
+
+	if route.Request != nil {
+		t := reflect.TypeOf(route.Request)
+		for _, f := range fields(t) {
+			switch {
+			case f.uri != "":
+				if param, ok := pathParams[f.uri]; ok {
+					param.Schema = s.of(f.typ)
+					param.Schema.Nullable = false
+				}
+			case f.form != "":
+				op.Parameters = append(op.Parameters, &Parameter{Name: f.form, In: "query", Schema: s.of(f.typ)})
+			}
+		}
+
+		// GET 请求只绑定路径参数和查询参数，其余请求中没有 uri 和 form 标签的 json 字段来自请求体
+		if route.Method != http.MethodGet {
+			body := s.object(t, func(f field) bool { return f.uri == "" && f.form == "" })
+			if len(body.Properties) > 0 {
+				op.RequestBody = &RequestBody{
+					Required: true,
+					Content:  map[string]*MediaType{"application/json": {Schema: body}},
+				}
+			}
+		}
+	}
+
+	contentType := route.ContentType
+	if contentType == "" {
+		contentType = "application/json"
+	}
+	ok := &Response{Description: "成功响应", Content: map[string]*MediaType{contentType: {}}}
+	if route.Response != nil {
+		ok.Content[contentType].Schema = s.of(reflect.TypeOf(route.Response))
+	}
+	op.Responses[strconv.Itoa(http.StatusOK)] = ok
+
+	return op
+}
+
+// specPath 将 gin 格式的路由路径转换为 OpenAPI 格式，例如 /v1/posts/:postID 转换为 /v1/posts/{postID}.
+func specPath(path string) string {
+	return ginParamRegexp.ReplaceAllString(path, "{$1}")
+}
+
+// Verify 检查 gin 中注册的路由和文档中的操作是否一一对应.
+// 新增路由时忘记补充文档，或者删除路由后文档中仍有残留时返回错误.
+func Verify(doc *Document, routes gin.RoutesInfo) error {
+	registered := map[string]bool{}
+	var missing, stale []string
+	for _, route := range routes {
+		path := specPath(route.Path)
+		registered[route.Method+" "+path] = true
+		if doc.Paths[path][strings.ToLower(route.Method)] == nil {
+			missing = append(missing, route.Method+" "+route.Path)
+		}
+	}
+
+	for path, item := range doc.Paths {
+		for method := range item {
+			if key := strings.ToUpper(method) + " " + path; !registered[key] {
+				stale = append(stale, key)
+			}
+		}
+	}
+
+	if len(missing) == 0 && len(stale) == 0 {
+		return nil
+	}
+
+	slices.Sort(missing)
+	slices.Sort(stale)
+	return fmt.Errorf("openapi document is out of sync with registered routes: missing %v, not registered %v", missing, stale)
+}
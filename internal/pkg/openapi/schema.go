@@ -0,0 +1,126 @@
+package openapi
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"time"
+)
+
+var (
+	timeType       = reflect.TypeOf(time.Time{})
+	rawMessageType = reflect.TypeOf(json.RawMessage{})
+)
+
+// field 表示结构体中参与 JSON 编解码或请求绑定的字段.
+type field struct {
+	// name 是 json 标签中的字段名.
+	name string
+	// uri 是 uri 标签中的路径参数名.
+	uri string
+	// form 是 form 标签中的查询参数名.
+	form string
+	typ  reflect.Type
+}
+
+// fields 返回结构体的字段，匿名嵌入的结构体字段会被展开，与 encoding/json 的行为一致.
+func fields(t reflect.Type) []field {
+	var ret []field
+	for i := range t.NumField() {
+		sf := t.Field(i)
+		name, _, _ := strings.Cut(sf.Tag.Get("json"), ",")
+		if sf.Anonymous && name == "" && sf.Type.Kind() == reflect.Struct {
+			ret = append(ret, fields(sf.Type)...)
+			continue
+		}
+		if !sf.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = sf.Name
+		}
+
+		uri, _, _ := strings.Cut(sf.Tag.Get("uri"), ",")
+		form, _, _ := strings.Cut(sf.Tag.Get("form"), ",")
+		ret = append(ret, field{name: name, uri: uri, form: form, typ: sf.Type})
+	}
+	return ret
+}
+
+// schemas 负责生成 Schema，命名的结构体会注册到 components 中并通过 $ref 引用.
+type schemas struct {
+	components map[string]*Schema
+}
+
+// of 返回类型 t 对应的 Schema.
+func (s *schemas) of(t reflect.Type) *Schema {
+	nullable := false
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+		nullable = true
+	}
+
+	schema := s.ofValue(t)
+	// OpenAPI 3.0 中与 $ref 并列的属性会被忽略，引用类型不标记 nullable
+	if nullable && schema.Ref == "" {
+		schema.Nullable = true
+	}
+	return schema
+}
+
+// ofValue 返回非指针类型 t 对应的 Schema.
+func (s *schemas) ofValue(t reflect.Type) *Schema {
+	switch t {
+	case timeType:
+		return &Schema{Type: "string", Format: "date-time"}
+	case rawMessageType:
+		return &Schema{}
+	}
+
+	switch t.Kind() {
+	case reflect.String:
+		return &Schema{Type: "string"}
+	case reflect.Bool:
+		return &Schema{Type: "boolean"}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		return &Schema{Type: "integer", Format: "int32"}
+	case reflect.Int64, reflect.Uint64:
+		return &Schema{Type: "integer", Format: "int64"}
+	case reflect.Float32:
+		return &Schema{Type: "number", Format: "float"}
+	case reflect.Float64:
+		return &Schema{Type: "number", Format: "double"}
+	case reflect.Slice, reflect.Array:
+		if t.Elem().Kind() == reflect.Uint8 {
+			return &Schema{Type: "string", Format: "byte"}
+		}
+		return &Schema{Type: "array", Items: s.of(t.Elem())}
+	case reflect.Map:
+		return &Schema{Type: "object", AdditionalProperties: s.of(t.Elem())}
+	case reflect.Struct:
+		if t.Name() == "" {
+			return s.object(t, nil)
+		}
+		if _, ok := s.components[t.Name()]; !ok {
+			// 先占位再生成，避免结构体引用自身时无限递归
+			s.components[t.Name()] = &Schema{}
+			*s.components[t.Name()] = *s.object(t, nil)
+		}
+		return &Schema{Ref: "#/components/schemas/" + t.Name()}
+	default:
+		// interface 等无法确定结构的类型可以是任意值
+		return &Schema{}
+	}
+}
+
+// object 返回结构体 t 对应的对象 Schema，keep 不为空时只保留 keep 返回 true 的字段.
+func (s *schemas) object(t reflect.Type, keep func(field) bool) *Schema {
+	schema := &Schema{Type: "object", Properties: map[string]*Schema{}}
+	for _, f := range fields(t) {
+		if f.name == "-" || (keep != nil && !keep(f)) {
+			continue
+		}
+		schema.Properties[f.name] = s.of(f.typ)
+	}
+	return schema
+}
@@ -0,0 +1,39 @@
+package openapi
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	swaggerfiles "github.com/swaggo/files/v2"
+)
+
+// UIHandler 返回内嵌 Swagger UI 的处理函数，需要注册在以 /*filepath 结尾的路由上，例如 /docs/*filepath.
+// Swagger UI 的静态文件打包在二进制中，不依赖外部 CDN. specURL 是 OpenAPI 文档的地址.
+func UIHandler(specURL string) gin.HandlerFunc {
+	// 替换 Swagger UI 自带的初始化脚本，使其加载指定的文档
+	initializer := fmt.Sprintf(`window.onload = function() {
+  window.ui = SwaggerUIBundle({
+    url: %q,
+    dom_id: "#swagger-ui",
+    deepLinking: true,
+    persistAuthorization: true,
+    presets: [SwaggerUIBundle.presets.apis, SwaggerUIStandalonePreset],
+    plugins: [SwaggerUIBundle.plugins.DownloadUrl],
+    layout: "StandaloneLayout"
+  });
+};
+`, specURL)
+
+	files := http.FS(swaggerfiles.FS)
+	return func(c *gin.Context) {
+		switch path := c.Param("filepath"); path {
+		case "/swagger-initializer.js":
+			c.Data(http.StatusOK, "application/javascript; charset=utf-8", []byte(initializer))
+		case "", "/", "/index.html":
+			c.FileFromFS("/", files)
+		default:
+			c.FileFromFS(path, files)
+		}
+	}
+}
@@ -0,0 +1,119 @@
+// Package pagination 实现了列表接口使用的不透明分页令牌.
+//
+// 分页令牌记录了上一页最后一条记录在排序中的位置（排序字段的值和记录 ID），
+// 下一页从该位置之后继续读取（keyset 分页），翻页期间有数据新增或删除也不会出现重复或遗漏.
+// 按相关度排序的检索结果没有稳定的排序字段，令牌中记录的是下一页的偏移量.
+// 令牌使用 HMAC-SHA256 签名，客户端无法伪造或修改令牌中的排序位置.
+package pagination
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"strings"
+	"sync"
+)
+
+// ErrInvalidToken 表示分页令牌格式错误或签名校验失败.
+var ErrInvalidToken = errors.New("invalid page token")
+
+var (
+	mu  sync.RWMutex
+	key []byte
+)
+
+// Token 表示分页令牌中保存的内容.
+type Token struct {
+	// SortBy 是签发令牌时使用的排序字段.
+	SortBy string `json:"s"`
+	// Desc 表示签发令牌时是否倒序排列.
+	Desc bool `json:"d,omitempty"`
+	// Scope 是签发令牌的查询标识（列表接口和过滤条件）的摘要，令牌只能用于相同的查询.
+	Scope string `json:"q,omitempty"`
+	// Value 是上一页最后一条记录排序字段的值.
+	Value json.RawMessage `json:"v,omitempty"`
+	// ID 是上一页最后一条记录的 ID，排序字段的值相同时用来确定顺序.
+	ID int64 `json:"i"`
+	// Offset 是下一页的偏移量，只用于无法使用 keyset 分页的查询，例如按相关度排序的检索结果.
+	Offset int `json:"o,omitempty"`
+}
+
+// Init 设置分页令牌的签名密钥，key 为空时随机生成一个密钥.
+// 随机密钥只在当前进程内有效，服务重启后之前签发的分页令牌会失效.
+func Init(k string) {
+	mu.Lock()
+	defer mu.Unlock()
+
+	key = newKey(k)
+}
+
+// newKey 返回 k 对应的密钥，k 为空时随机生成一个密钥.
+func newKey(k string) []byte {
+	if k != "" {
+		return []byte(k)
+	}
+
+	b := make([]byte, 32)
+	_, _ = rand.Read(b)
+	return b
+}
+
+// signingKey 返回签名密钥，未调用 Init 时随机生成一个密钥.
+func signingKey() []byte {
+	mu.RLock()
+	k := key
+	mu.RUnlock()
+	if k != nil {
+		return k
+	}
+
+	mu.Lock()
+	defer mu.Unlock()
+	if key == nil {
+		key = newKey("")
+	}
+	return key
+}
+
+// Scope 计算查询标识的摘要，用于填充 Token.Scope.
+func Scope(s string) string {
+	sum := sha256.Sum256([]byte(s))
+	return base64.RawURLEncoding.EncodeToString(sum[:8])
+}
+
+// Encode 将分页令牌编码为签名后的字符串.
+func Encode(t *Token) string {
+	data, _ := json.Marshal(t)
+	payload := base64.RawURLEncoding.EncodeToString(data)
+	return payload + "." + sign(payload)
+}
+
+// Decode 校验分页令牌的签名并解析令牌内容.
+func Decode(s string) (*Token, error) {
+	payload, signature, ok := strings.Cut(s, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(sign(payload))) {
+		return nil, ErrInvalidToken
+	}
+
+	data, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	var t Token
+	if err := json.Unmarshal(data, &t); err != nil {
+		return nil, ErrInvalidToken
+	}
+
+	return &t, nil
+}
+
+// sign 计算 payload 的 HMAC-SHA256 签名.
+func sign(payload string) string {
+	mac := hmac.New(sha256.New, signingKey())
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,87 @@
+package pagination
+
+import (
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func init() {
+	Init("pagination-test-key-0123456789abcdef")
+}
+
+func TestEncodeDecode(t *testing.T) {
+	want := &Token{SortBy: "createdAt", Desc: true, Scope: Scope("users[]"), Value: json.RawMessage(`"2024-01-01T00:00:00Z"`), ID: 42}
+
+	got, err := Decode(Encode(want))
+	if err != nil {
+		t.Fatalf("Decode() error = %v", err)
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Decode() = %+v, want %+v", got, want)
+	}
+}
+
+func TestDecodeTampered(t *testing.T) {
+	token := Encode(&Token{SortBy: "createdAt", Scope: Scope("users[]"), Value: json.RawMessage(`10`), ID: 7})
+	payload, signature, _ := strings.Cut(token, ".")
+
+	// 修改令牌内容后沿用原来的签名
+	forged, _ := json.Marshal(&Token{SortBy: "createdAt", Scope: Scope("users[]"), Value: json.RawMessage(`0`), ID: 1})
+	forgedPayload := base64.RawURLEncoding.EncodeToString(forged)
+
+	// 修改签名中的一个字符
+	flipped := []byte(signature)
+	if flipped[0] == 'A' {
+		flipped[0] = 'B'
+	} else {
+		flipped[0] = 'A'
+	}
+
+	tests := []struct {
+		name  string
+		token string
+	}{
+		{name: "empty", token: ""},
+		{name: "no signature", token: payload},
+		{name: "empty signature", token: payload + "."},
+		{name: "modified signature", token: payload + "." + string(flipped)},
+		{name: "truncated signature", token: payload + "." + signature[:len(signature)-1]},
+		{name: "modified payload", token: forgedPayload + "." + signature},
+		{name: "swapped parts", token: signature + "." + payload},
+		{name: "extra part", token: token + ".x"},
+		{name: "garbage", token: "not-a-token"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := Decode(tt.token); !errors.Is(err, ErrInvalidToken) {
+				t.Errorf("Decode(%q) error = %v, want ErrInvalidToken", tt.token, err)
+			}
+		})
+	}
+}
+
+func TestDecodeWithAnotherKey(t *testing.T) {
+	token := Encode(&Token{SortBy: "createdAt", ID: 1})
+
+	Init("another-pagination-test-key-0123456789")
+	defer Init("pagination-test-key-0123456789abcdef")
+
+	// 其它实例使用不同密钥签发的令牌不能通过校验
+	if _, err := Decode(token); !errors.Is(err, ErrInvalidToken) {
+		t.Errorf("Decode() with another key error = %v, want ErrInvalidToken", err)
+	}
+}
+
+func TestScope(t *testing.T) {
+	if Scope(`users["a"]`) != Scope(`users["a"]`) {
+		t.Error("Scope() is not deterministic")
+	}
+	if Scope(`users["a"]`) == Scope(`users["b"]`) {
+		t.Error("Scope() returns the same digest for different queries")
+	}
+}
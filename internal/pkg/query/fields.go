@@ -0,0 +1,114 @@
+package query
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// Fields 解析以逗号分隔的字段列表，字段名必须是 item 类型的 JSON 字段. fields 为空时返回 nil，表示返回所有字段.
+func Fields(fields string, item any) ([]string, error) {
+	if strings.TrimSpace(fields) == "" {
+		return nil, nil
+	}
+
+	allowed := jsonFields(reflect.TypeOf(item))
+	names := SplitFields(fields)
+	for _, name := range names {
+		if !slices.Contains(allowed, name) {
+			return nil, fmt.Errorf("fields: unknown field %q, must be one of %v", name, allowed)
+		}
+	}
+
+	return names, nil
+}
+
+// SplitFields 将以逗号分隔的字段列表拆分为字段名，忽略空白和空字段.
+func SplitFields(fields string) []string {
+	var names []string
+	for _, name := range strings.Split(fields, ",") {
+		if name = strings.TrimSpace(name); name != "" {
+			names = append(names, name)
+		}
+	}
+	return names
+}
+
+// jsonFields 返回结构体类型的 JSON 字段名，包括匿名嵌入结构体中的字段.
+func jsonFields(t reflect.Type) []string {
+	for t.Kind() == reflect.Pointer {
+		t = t.Elem()
+	}
+
+	var names []string
+	for i := range t.NumField() {
+		f := t.Field(i)
+		name, _, _ := strings.Cut(f.Tag.Get("json"), ",")
+		if name == "-" {
+			continue
+		}
+		// 与 encoding/json 一致，未导出的匿名嵌入结构体中的导出字段同样会被序列化
+		if f.Anonymous && name == "" {
+			ft := f.Type
+			for ft.Kind() == reflect.Pointer {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				names = append(names, jsonFields(ft)...)
+				continue
+			}
+		}
+		if !f.IsExported() {
+			continue
+		}
+		if name == "" {
+			name = f.Name
+		}
+		names = append(names, name)
+	}
+	return names
+}
+
+// Project 只保留列表响应中每个对象的指定字段，响应中的其它字段（例如总数和分页令牌）保持不变.
+// fields 为空时直接返回 resp.
+func Project(resp any, fields []string) (any, error) {
+	if len(fields) == 0 {
+		return resp, nil
+	}
+
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return nil, err
+	}
+
+	// 使用 json.Number 避免大整数被转换为 float64 后丢失精度
+	dec := json.NewDecoder(bytes.NewReader(data))
+	dec.UseNumber()
+	var m map[string]any
+	if err := dec.Decode(&m); err != nil {
+		return nil, err
+	}
+
+	for _, value := range m {
+		items, ok := value.([]any)
+		if !ok {
+			continue
+		}
+		for _, item := range items {
+			obj, ok := item.(map[string]any)
+			if !ok {
+				continue
+			}
+			for key := range obj {
+				if !slices.Contains(fields, key) {
+					delete(obj, key)
+				}
+			}
+		}
+	}
+
+	return m, nil
+}
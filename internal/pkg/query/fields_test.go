@@ -0,0 +1,86 @@
+package query
+
+import (
+	"encoding/json"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+type embeddedItem struct {
+	CreatedAt string `json:"createdAt"`
+}
+
+type fieldsItem struct {
+	embeddedItem
+	ID       int64  `json:"id"`
+	Title    string `json:"title,omitempty"`
+	Password string `json:"-"`
+	Plain    string
+	private  string
+}
+
+type fieldsResponse struct {
+	TotalCount    int64         `json:"totalCount"`
+	Items         []*fieldsItem `json:"items"`
+	NextPageToken string        `json:"nextPageToken"`
+}
+
+func TestFields(t *testing.T) {
+	tests := []struct {
+		fields  string
+		want    []string
+		wantErr string
+	}{
+		{fields: "", want: nil},
+		{fields: " , ", want: nil},
+		{fields: "id", want: []string{"id"}},
+		{fields: " id , title,,createdAt ", want: []string{"id", "title", "createdAt"}},
+		{fields: "Plain", want: []string{"Plain"}},
+		{fields: "id,password", wantErr: `unknown field "password"`},
+		{fields: "Password", wantErr: `unknown field "Password"`},
+		{fields: "private", wantErr: `unknown field "private"`},
+		{fields: "ID", wantErr: `unknown field "ID"`},
+		{fields: "embeddedItem", wantErr: `unknown field "embeddedItem"`},
+	}
+
+	for _, tt := range tests {
+		got, err := Fields(tt.fields, &fieldsItem{})
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Fields(%q) error = %v, want error containing %q", tt.fields, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil || !reflect.DeepEqual(got, tt.want) {
+			t.Errorf("Fields(%q) = %v, %v, want %v", tt.fields, got, err, tt.want)
+		}
+	}
+}
+
+func TestProject(t *testing.T) {
+	resp := &fieldsResponse{
+		TotalCount:    2,
+		Items:         []*fieldsItem{{ID: 9007199254740993, Title: "a", embeddedItem: embeddedItem{CreatedAt: "2024"}}, {ID: 2, Title: "b"}},
+		NextPageToken: "next",
+	}
+
+	if got, err := Project(resp, nil); err != nil || got != resp {
+		t.Errorf("Project() without fields = %v, %v, want the original response", got, err)
+	}
+
+	got, err := Project(resp, []string{"id", "createdAt"})
+	if err != nil {
+		t.Fatalf("Project() error = %v", err)
+	}
+	data, err := json.Marshal(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// 只保留列表中对象的指定字段，总数、分页令牌和大整数保持不变
+	want := `{"items":[{"createdAt":"2024","id":9007199254740993},{"createdAt":"","id":2}],"nextPageToken":"next","totalCount":2}`
+	if string(data) != want {
+		t.Errorf("Project() = %s, want %s", data, want)
+	}
+}
@@ -0,0 +1,138 @@
+package query
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// tokenKind 定义了过滤条件中的词法单元类型.
+type tokenKind int
+
+const (
+	tokenEOF tokenKind = iota
+	tokenIdent
+	tokenAnd
+	tokenOr
+	tokenLParen
+	tokenRParen
+	tokenValue
+	tokenNull
+)
+
+// token 表示过滤条件中的一个词法单元.
+type token struct {
+	kind tokenKind
+	text string
+	pos  int
+}
+
+// operators 按照长度从长到短排列，保证 >= 不会被识别为 >.
+var operators = []string{">=", "<=", "!=", "=", ">", "<", "~"}
+
+// lexer 是过滤条件的词法分析器. 比较运算符和值的识别依赖上下文，由解析器分别调用 operator 和 value 读取.
+type lexer struct {
+	input string
+	pos   int
+}
+
+func (l *lexer) skipSpace() {
+	for l.pos < len(l.input) && unicode.IsSpace(rune(l.input[l.pos])) {
+		l.pos++
+	}
+}
+
+// next 读取下一个字段名、关键字或括号.
+func (l *lexer) next() (token, error) {
+	l.skipSpace()
+	start := l.pos
+	if l.pos >= len(l.input) {
+		return token{kind: tokenEOF, pos: start}, nil
+	}
+
+	switch c := l.input[l.pos]; {
+	case c == '(':
+		l.pos++
+		return token{kind: tokenLParen, text: "(", pos: start}, nil
+	case c == ')':
+		l.pos++
+		return token{kind: tokenRParen, text: ")", pos: start}, nil
+	case isIdentChar(c):
+		for l.pos < len(l.input) && isIdentChar(l.input[l.pos]) {
+			l.pos++
+		}
+		text := l.input[start:l.pos]
+		switch strings.ToUpper(text) {
+		case "AND":
+			return token{kind: tokenAnd, text: text, pos: start}, nil
+		case "OR":
+			return token{kind: tokenOr, text: text, pos: start}, nil
+		}
+		return token{kind: tokenIdent, text: text, pos: start}, nil
+	default:
+		return token{}, fmt.Errorf("filter: unexpected character %q at position %d", c, start)
+	}
+}
+
+// operator 读取字段名之后的比较运算符.
+func (l *lexer) operator() (string, error) {
+	l.skipSpace()
+	for _, op := range operators {
+		if strings.HasPrefix(l.input[l.pos:], op) {
+			l.pos += len(op)
+			return op, nil
+		}
+	}
+	return "", fmt.Errorf("filter: expected operator at position %d, must be one of %v", l.pos, operators)
+}
+
+// value 读取比较运算符之后的值. 值可以使用双引号，引号内使用 \" 和 \\ 转义；
+// 未加引号的值到空白字符或右括号为止，并且不能以运算符字符开头，避免 <> 和 == 等写法被误解析.
+func (l *lexer) value() (token, error) {
+	l.skipSpace()
+	start := l.pos
+	if l.pos >= len(l.input) {
+		return token{}, fmt.Errorf("filter: expected value at position %d", start)
+	}
+
+	if l.input[l.pos] == '"' {
+		var b strings.Builder
+		l.pos++
+		for l.pos < len(l.input) {
+			c := l.input[l.pos]
+			switch {
+			case c == '\\' && l.pos+1 < len(l.input):
+				b.WriteByte(l.input[l.pos+1])
+				l.pos += 2
+			case c == '"':
+				l.pos++
+				return token{kind: tokenValue, text: b.String(), pos: start}, nil
+			default:
+				b.WriteByte(c)
+				l.pos++
+			}
+		}
+		return token{}, fmt.Errorf("filter: unterminated string at position %d", start)
+	}
+
+	if strings.ContainsRune("=!<>~", rune(l.input[l.pos])) {
+		return token{}, fmt.Errorf("filter: unexpected %q at position %d, values starting with an operator must be quoted", l.input[l.pos], start)
+	}
+
+	for l.pos < len(l.input) && !unicode.IsSpace(rune(l.input[l.pos])) && l.input[l.pos] != ')' {
+		l.pos++
+	}
+	text := l.input[start:l.pos]
+	if text == "" {
+		return token{}, fmt.Errorf("filter: expected value at position %d", start)
+	}
+	if text == "null" {
+		return token{kind: tokenNull, text: text, pos: start}, nil
+	}
+
+	return token{kind: tokenValue, text: text, pos: start}, nil
+}
+
+func isIdentChar(c byte) bool {
+	return c == '_' || c >= 'a' && c <= 'z' || c >= 'A' && c <= 'Z' || c >= '0' && c <= '9'
+}
@@ -0,0 +1,317 @@
+// Package query 实现了列表接口使用的简单查询语言，包括过滤条件（filter）、排序（orderBy）和字段选择（fields）.
+//
+// 过滤条件由比较表达式、AND、OR 和括号组成，AND 的优先级高于 OR，例如：
+//
+//	createdAt>2024-01-01 AND (title~go OR status=published)
+//
+// 支持的比较运算符：= != > >= < <= ~（包含，按字面值匹配）. 包含空格、括号或以运算符开头的值需要使用双引号，
+// 未加引号的 null 表示 NULL，只能用于 = 和 !=，例如 publishedAt!=null.
+// 只有 Schema 中列出的字段可以用于过滤和排序，值按照字段类型解析后作为参数传给数据库，不会拼接到 SQL 中.
+package query
+
+import (
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm/clause"
+)
+
+const (
+	// MaxFilterLength 定义过滤条件的最大长度.
+	MaxFilterLength = 1024
+	// MaxFilterTerms 定义过滤条件中比较表达式的最大数量.
+	MaxFilterTerms = 20
+)
+
+// likeEscaper 转义 LIKE 中的通配符，使 ~ 按照字面值匹配. MySQL 字符串中的反斜杠本身需要转义，所以使用 ! 作为转义字符.
+var likeEscaper = strings.NewReplacer("!", "!!", "%", "!%", "_", "!_")
+
+// FieldType 定义了字段值的类型，决定过滤条件中的值如何解析.
+type FieldType int
+
+const (
+	// String 表示字符串字段，支持所有运算符.
+	String FieldType = iota
+	// Int 表示整数字段，不支持 ~ 运算符.
+	Int
+	// Time 表示时间字段，值可以是 RFC3339 格式的时间或 2006-01-02 格式的日期，不支持 ~ 运算符.
+	Time
+)
+
+// Field 定义了允许查询的字段.
+type Field struct {
+	// Column 是字段对应的数据库列名.
+	Column string
+	// Type 是字段值的类型.
+	Type FieldType
+	// Nullable 表示字段是否可以为 NULL，可以为 NULL 的字段支持 =null 和 !=null 过滤.
+	Nullable bool
+	// Sortable 表示字段是否可以用于排序. 分页依赖排序字段的值，只有不为 NULL 的字段可以排序.
+	Sortable bool
+}
+
+// Schema 定义了一种资源允许查询的字段和默认排序方式.
+type Schema struct {
+	// Fields 是允许过滤和排序的字段，键是接口中使用的字段名.
+	Fields map[string]Field
+	// DefaultSort 是默认的排序字段.
+	DefaultSort string
+	// DefaultDesc 表示默认是否倒序排列.
+	DefaultDesc bool
+}
+
+// With 返回包含额外字段的 Schema 副本，用于在基础 Schema 上扩展字段，例如回收站列表中的 deletedAt.
+func (s *Schema) With(fields map[string]Field) *Schema {
+	merged := make(map[string]Field, len(s.Fields)+len(fields))
+	for name, field := range s.Fields {
+		merged[name] = field
+	}
+	for name, field := range fields {
+		merged[name] = field
+	}
+
+	return &Schema{Fields: merged, DefaultSort: s.DefaultSort, DefaultDesc: s.DefaultDesc}
+}
+
+// Sortable 返回允许排序的字段.
+func (s *Schema) Sortable() []string {
+	var names []string
+	for name, field := range s.Fields {
+		if field.Sortable {
+			names = append(names, name)
+		}
+	}
+	slices.Sort(names)
+	return names
+}
+
+// Filter 解析过滤条件，返回可以传给 where.Options.C 的条件表达式. filter 为空时返回 nil.
+func (s *Schema) Filter(filter string) (clause.Expression, error) {
+	if strings.TrimSpace(filter) == "" {
+		return nil, nil
+	}
+	if len(filter) > MaxFilterLength {
+		return nil, fmt.Errorf("filter is too long, at most %d characters", MaxFilterLength)
+	}
+
+	p := &parser{schema: s, lexer: lexer{input: filter}}
+	if err := p.next(); err != nil {
+		return nil, err
+	}
+
+	var expr clause.Expr
+	if err := p.parseOr(&expr); err != nil {
+		return nil, err
+	}
+	if p.tok.kind != tokenEOF {
+		return nil, fmt.Errorf("filter: unexpected %q at position %d", p.tok.text, p.tok.pos)
+	}
+
+	return expr, nil
+}
+
+// OrderBy 解析排序表达式，格式为 "字段 [asc|desc]"，返回排序字段和排序方向.
+// 未指定排序方向时 order 为空字符串，使用列表接口默认的排序方向.
+func (s *Schema) OrderBy(orderBy string) (field string, order string, err error) {
+	parts := strings.Fields(orderBy)
+	if len(parts) == 0 || len(parts) > 2 {
+		return "", "", fmt.Errorf("orderBy must be in the form 'field [asc|desc]'")
+	}
+
+	field = parts[0]
+	if f, ok := s.Fields[field]; !ok || !f.Sortable {
+		return "", "", fmt.Errorf("orderBy: field %q is not sortable, must be one of %v", field, s.Sortable())
+	}
+
+	if len(parts) == 2 {
+		order = strings.ToLower(parts[1])
+		if order != "asc" && order != "desc" {
+			return "", "", fmt.Errorf("orderBy: invalid order %q, must be asc or desc", parts[1])
+		}
+	}
+
+	return field, order, nil
+}
+
+// parser 是过滤条件的递归下降解析器，解析结果直接生成带参数的 SQL 表达式.
+type parser struct {
+	schema *Schema
+	lexer  lexer
+	tok    token
+	terms  int
+}
+
+func (p *parser) next() error {
+	tok, err := p.lexer.next()
+	if err != nil {
+		return err
+	}
+	p.tok = tok
+	return nil
+}
+
+// parseOr 解析 and-expr { OR and-expr }.
+func (p *parser) parseOr(expr *clause.Expr) error {
+	var exprs []clause.Expr
+	for {
+		var and clause.Expr
+		if err := p.parseAnd(&and); err != nil {
+			return err
+		}
+		exprs = append(exprs, and)
+
+		if p.tok.kind != tokenOr {
+			break
+		}
+		if err := p.next(); err != nil {
+			return err
+		}
+	}
+
+	*expr = join(exprs, " OR ")
+	return nil
+}
+
+// parseAnd 解析 term { AND term }.
+func (p *parser) parseAnd(expr *clause.Expr) error {
+	var exprs []clause.Expr
+	for {
+		var term clause.Expr
+		if err := p.parseTerm(&term); err != nil {
+			return err
+		}
+		exprs = append(exprs, term)
+
+		if p.tok.kind != tokenAnd {
+			break
+		}
+		if err := p.next(); err != nil {
+			return err
+		}
+	}
+
+	*expr = join(exprs, " AND ")
+	return nil
+}
+
+// parseTerm 解析 ( or-expr ) 或者 field op value.
+func (p *parser) parseTerm(expr *clause.Expr) error {
+	if p.tok.kind == tokenLParen {
+		if err := p.next(); err != nil {
+			return err
+		}
+		if err := p.parseOr(expr); err != nil {
+			return err
+		}
+		if p.tok.kind != tokenRParen {
+			return fmt.Errorf("filter: expected ')' at position %d", p.tok.pos)
+		}
+		return p.next()
+	}
+
+	if p.tok.kind != tokenIdent {
+		return fmt.Errorf("filter: expected field name at position %d", p.tok.pos)
+	}
+	name := p.tok.text
+	field, ok := p.schema.Fields[name]
+	if !ok {
+		return fmt.Errorf("filter: unknown field %q", name)
+	}
+
+	p.terms++
+	if p.terms > MaxFilterTerms {
+		return fmt.Errorf("filter: too many conditions, at most %d", MaxFilterTerms)
+	}
+
+	op, err := p.lexer.operator()
+	if err != nil {
+		return err
+	}
+	value, err := p.lexer.value()
+	if err != nil {
+		return err
+	}
+
+	*expr, err = compare(name, field, op, value)
+	if err != nil {
+		return err
+	}
+
+	return p.next()
+}
+
+// compare 根据字段类型解析比较表达式中的值，生成对应的 SQL 表达式.
+func compare(name string, field Field, op string, value token) (clause.Expr, error) {
+	column := clause.Column{Name: field.Column}
+
+	if value.kind == tokenNull {
+		if !field.Nullable {
+			return clause.Expr{}, fmt.Errorf("filter: field %q cannot be null", name)
+		}
+		switch op {
+		case "=":
+			return clause.Expr{SQL: "? IS NULL", Vars: []any{column}}, nil
+		case "!=":
+			return clause.Expr{SQL: "? IS NOT NULL", Vars: []any{column}}, nil
+		default:
+			return clause.Expr{}, fmt.Errorf("filter: null can only be used with = and !=")
+		}
+	}
+
+	if op == "~" {
+		if field.Type != String {
+			return clause.Expr{}, fmt.Errorf("filter: operator ~ can only be used with text fields, %q is not", name)
+		}
+		return clause.Expr{SQL: "? LIKE ? ESCAPE '!'", Vars: []any{column, "%" + likeEscaper.Replace(value.text) + "%"}}, nil
+	}
+
+	var arg any
+	switch field.Type {
+	case Int:
+		n, err := strconv.ParseInt(value.text, 10, 64)
+		if err != nil {
+			return clause.Expr{}, fmt.Errorf("filter: invalid integer %q for field %q", value.text, name)
+		}
+		arg = n
+	case Time:
+		t, err := parseTime(value.text)
+		if err != nil {
+			return clause.Expr{}, fmt.Errorf("filter: invalid time %q for field %q, use RFC3339 or YYYY-MM-DD", value.text, name)
+		}
+		arg = t
+	default:
+		arg = value.text
+	}
+
+	if op == "!=" {
+		op = "<>"
+	}
+	return clause.Expr{SQL: "? " + op + " ?", Vars: []any{column, arg}}, nil
+}
+
+// parseTime 解析 RFC3339 格式的时间或 2006-01-02 格式的日期，日期按照 UTC 零点处理.
+func parseTime(s string) (time.Time, error) {
+	if t, err := time.Parse(time.RFC3339, s); err == nil {
+		return t, nil
+	}
+	return time.Parse(time.DateOnly, s)
+}
+
+// join 使用 sep 连接多个表达式，多于一个表达式时加上括号以保证优先级.
+func join(exprs []clause.Expr, sep string) clause.Expr {
+	if len(exprs) == 1 {
+		return exprs[0]
+	}
+
+	sqls := make([]string, 0, len(exprs))
+	vars := make([]any, 0, len(exprs))
+	for _, expr := range exprs {
+		sqls = append(sqls, "?")
+		vars = append(vars, expr)
+	}
+
+	return clause.Expr{SQL: "(" + strings.Join(sqls, sep) + ")", Vars: vars}
+}
@@ -0,0 +1,384 @@
+package query
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/onexstack/fastgo/internal/pkg/testdb"
+)
+
+// testSchema 是测试使用的 Schema，覆盖所有字段类型.
+var testSchema = &Schema{
+	Fields: map[string]Field{
+		"name":      {Column: "name"},
+		"title":     {Column: "post_title"},
+		"age":       {Column: "age", Type: Int, Sortable: true},
+		"createdAt": {Column: "created_at", Type: Time, Sortable: true},
+		"deletedAt": {Column: "deleted_at", Type: Time, Nullable: true},
+	},
+	DefaultSort: "createdAt",
+	DefaultDesc: true,
+}
+
+// openTestDB 打开一个 SQLite 内存数据库，只用于生成 SQL 时 dryRun 为 true.
+func openTestDB(t *testing.T, dryRun bool) *gorm.DB {
+	t.Helper()
+
+	db := testdb.New(t)
+	if dryRun {
+		return db.Session(&gorm.Session{DryRun: true})
+	}
+	return db
+}
+
+// render 返回过滤条件生成的 WHERE 子句和参数.
+func render(t *testing.T, db *gorm.DB, expr clause.Expression) (string, []any) {
+	t.Helper()
+
+	stmt := db.Table("t").Where(expr).Find(&[]map[string]any{}).Statement
+	_, where, _ := strings.Cut(stmt.SQL.String(), " WHERE ")
+	return where, stmt.Vars
+}
+
+func date(s string) time.Time {
+	t, _ := time.Parse(time.DateOnly, s)
+	return t
+}
+
+func TestFilter(t *testing.T) {
+	db := openTestDB(t, true)
+
+	tests := []struct {
+		name     string
+		filter   string
+		wantSQL  string
+		wantVars []any
+	}{
+		{
+			name:     "single comparison",
+			filter:   "name=alice",
+			wantSQL:  "`name` = ?",
+			wantVars: []any{"alice"},
+		},
+		{
+			name:     "column mapping",
+			filter:   "title=go",
+			wantSQL:  "`post_title` = ?",
+			wantVars: []any{"go"},
+		},
+		{
+			name:     "all operators",
+			filter:   "age=1 AND age!=2 AND age>3 AND age>=4 AND age<5 AND age<=6 AND name~x",
+			wantSQL:  "(`age` = ? AND `age` <> ? AND `age` > ? AND `age` >= ? AND `age` < ? AND `age` <= ? AND `name` LIKE ? ESCAPE '!')",
+			wantVars: []any{int64(1), int64(2), int64(3), int64(4), int64(5), int64(6), "%x%"},
+		},
+		{
+			name:     "spaces around operators",
+			filter:   "  age >= 18  ",
+			wantSQL:  "`age` >= ?",
+			wantVars: []any{int64(18)},
+		},
+		{
+			name:     "AND binds tighter than OR",
+			filter:   "name=a OR name=b AND age=1",
+			wantSQL:  "(`name` = ? OR (`name` = ? AND `age` = ?))",
+			wantVars: []any{"a", "b", int64(1)},
+		},
+		{
+			name:     "AND before OR",
+			filter:   "name=a AND age=1 OR name=b",
+			wantSQL:  "((`name` = ? AND `age` = ?) OR `name` = ?)",
+			wantVars: []any{"a", int64(1), "b"},
+		},
+		{
+			name:     "parentheses override precedence",
+			filter:   "(name=a OR name=b) AND age=1",
+			wantSQL:  "((`name` = ? OR `name` = ?) AND `age` = ?)",
+			wantVars: []any{"a", "b", int64(1)},
+		},
+		{
+			name:     "nested parentheses",
+			filter:   "((name=a)) AND (age=1 OR (age=2 AND name=b))",
+			wantSQL:  "(`name` = ? AND (`age` = ? OR (`age` = ? AND `name` = ?)))",
+			wantVars: []any{"a", int64(1), int64(2), "b"},
+		},
+		{
+			name:     "case insensitive keywords",
+			filter:   "name=a and age=1 Or name=b",
+			wantSQL:  "((`name` = ? AND `age` = ?) OR `name` = ?)",
+			wantVars: []any{"a", int64(1), "b"},
+		},
+		{
+			name:     "unquoted value ends at right parenthesis",
+			filter:   "(name=a)",
+			wantSQL:  "`name` = ?",
+			wantVars: []any{"a"},
+		},
+		{
+			name:     "quoted value with spaces and parentheses",
+			filter:   `name="a (b) OR c"`,
+			wantSQL:  "`name` = ?",
+			wantVars: []any{"a (b) OR c"},
+		},
+		{
+			name:     "escaped quote and backslash",
+			filter:   `name="say \"hi\" \\o/"`,
+			wantSQL:  "`name` = ?",
+			wantVars: []any{`say "hi" \o/`},
+		},
+		{
+			name:     "empty quoted value",
+			filter:   `name=""`,
+			wantSQL:  "`name` = ?",
+			wantVars: []any{""},
+		},
+		{
+			name:     "quoted value starting with an operator",
+			filter:   `name="=x"`,
+			wantSQL:  "`name` = ?",
+			wantVars: []any{"=x"},
+		},
+		{
+			name:     "SQL in value is a parameter",
+			filter:   `name="x' OR 1=1 --"`,
+			wantSQL:  "`name` = ?",
+			wantVars: []any{"x' OR 1=1 --"},
+		},
+		{
+			name:     "operator characters in unquoted value",
+			filter:   "name=a=b",
+			wantSQL:  "`name` = ?",
+			wantVars: []any{"a=b"},
+		},
+		{
+			name:     "LIKE wildcards are escaped",
+			filter:   `name~"50%_off!"`,
+			wantSQL:  "`name` LIKE ? ESCAPE '!'",
+			wantVars: []any{"%50!%!_off!!%"},
+		},
+		{
+			name:    "is null",
+			filter:  "deletedAt=null",
+			wantSQL: "`deleted_at` IS NULL",
+		},
+		{
+			name:    "is not null",
+			filter:  "deletedAt!=null",
+			wantSQL: "`deleted_at` IS NOT NULL",
+		},
+		{
+			name:     "quoted null is a string",
+			filter:   `name="null"`,
+			wantSQL:  "`name` = ?",
+			wantVars: []any{"null"},
+		},
+		{
+			name:     "nullable field compared with a value",
+			filter:   "deletedAt<2024-01-01",
+			wantSQL:  "`deleted_at` < ?",
+			wantVars: []any{date("2024-01-01")},
+		},
+		{
+			name:     "RFC3339 time",
+			filter:   "createdAt>2024-01-02T03:04:05Z",
+			wantSQL:  "`created_at` > ?",
+			wantVars: []any{time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)},
+		},
+		{
+			name:     "negative integer",
+			filter:   "age>-1",
+			wantSQL:  "`age` > ?",
+			wantVars: []any{int64(-1)},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr, err := testSchema.Filter(tt.filter)
+			if err != nil {
+				t.Fatalf("Filter(%q) error = %v", tt.filter, err)
+			}
+
+			sql, vars := render(t, db, expr)
+			if sql != tt.wantSQL {
+				t.Errorf("Filter(%q) SQL = %s, want %s", tt.filter, sql, tt.wantSQL)
+			}
+			if len(vars) != 0 || len(tt.wantVars) != 0 {
+				if !reflect.DeepEqual(vars, tt.wantVars) {
+					t.Errorf("Filter(%q) vars = %#v, want %#v", tt.filter, vars, tt.wantVars)
+				}
+			}
+		})
+	}
+}
+
+func TestFilterEmpty(t *testing.T) {
+	for _, filter := range []string{"", "   ", "\t\n"} {
+		expr, err := testSchema.Filter(filter)
+		if err != nil || expr != nil {
+			t.Errorf("Filter(%q) = %v, %v, want nil, nil", filter, expr, err)
+		}
+	}
+}
+
+func TestFilterErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		filter  string
+		wantErr string
+	}{
+		{name: "unknown field", filter: "password=x", wantErr: `unknown field "password"`},
+		{name: "column name is not a field", filter: "post_title=x", wantErr: `unknown field "post_title"`},
+		{name: "field names are case sensitive", filter: "Name=x", wantErr: `unknown field "Name"`},
+		{name: "missing operator", filter: "name", wantErr: "expected operator"},
+		{name: "double equals", filter: "name==x", wantErr: "values starting with an operator must be quoted"},
+		{name: "unsupported operator", filter: "name<>x", wantErr: "values starting with an operator must be quoted"},
+		{name: "unknown operator", filter: "name^x", wantErr: "expected operator"},
+		{name: "missing value", filter: "name=", wantErr: "expected value"},
+		{name: "missing value before parenthesis", filter: "(name=)", wantErr: "expected value"},
+		{name: "unterminated string", filter: `name="abc`, wantErr: "unterminated string"},
+		{name: "escaped closing quote", filter: `name="abc\"`, wantErr: "unterminated string"},
+		{name: "missing right parenthesis", filter: "(name=a OR name=b", wantErr: "expected ')'"},
+		{name: "extra right parenthesis", filter: "name=a)", wantErr: `unexpected ")"`},
+		{name: "empty parentheses", filter: "()", wantErr: "expected field name"},
+		{name: "trailing AND", filter: "name=a AND", wantErr: "expected field name"},
+		{name: "leading OR", filter: "OR name=a", wantErr: "expected field name"},
+		{name: "double AND", filter: "name=a AND AND age=1", wantErr: "expected field name"},
+		{name: "missing AND", filter: "name=a age=1", wantErr: `unexpected "age"`},
+		{name: "unexpected character", filter: "name=a; age=1", wantErr: `unexpected "age"`},
+		{name: "unexpected character before field", filter: "'name'=a", wantErr: "unexpected character"},
+		{name: "integer type mismatch", filter: "age=abc", wantErr: `invalid integer "abc" for field "age"`},
+		{name: "integer overflow", filter: "age=99999999999999999999", wantErr: "invalid integer"},
+		{name: "float for integer", filter: "age=1.5", wantErr: "invalid integer"},
+		{name: "time type mismatch", filter: "createdAt>yesterday", wantErr: `invalid time "yesterday" for field "createdAt"`},
+		{name: "invalid date", filter: "createdAt>2024-13-01", wantErr: "invalid time"},
+		{name: "contains on integer", filter: "age~1", wantErr: "operator ~ can only be used with text fields"},
+		{name: "contains on time", filter: "createdAt~2024", wantErr: "operator ~ can only be used with text fields"},
+		{name: "null on non-nullable field", filter: "name=null", wantErr: `field "name" cannot be null`},
+		{name: "null with comparison", filter: "deletedAt>null", wantErr: "null can only be used with = and !="},
+		{name: "null with contains", filter: "deletedAt~null", wantErr: "null can only be used with = and !="},
+		{name: "too long", filter: "name=" + strings.Repeat("a", MaxFilterLength), wantErr: "filter is too long"},
+		{name: "too many terms", filter: strings.Repeat("age=1 OR ", MaxFilterTerms) + "age=1", wantErr: "too many conditions"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			expr, err := testSchema.Filter(tt.filter)
+			if err == nil {
+				t.Fatalf("Filter(%q) = %v, want error containing %q", tt.filter, expr, tt.wantErr)
+			}
+			if !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Filter(%q) error = %q, want error containing %q", tt.filter, err, tt.wantErr)
+			}
+		})
+	}
+}
+
+// TestFilterExecute 在 SQLite 中执行过滤条件，校验生成的 SQL 语义正确.
+func TestFilterExecute(t *testing.T) {
+	type row struct {
+		ID        int64 `gorm:"primaryKey"`
+		Name      string
+		PostTitle string
+		Age       int64
+		DeletedAt *time.Time
+	}
+
+	db := openTestDB(t, false)
+	if err := db.Table("t").AutoMigrate(&row{}); err != nil {
+		t.Fatal(err)
+	}
+	deleted := date("2024-06-01")
+	rows := []row{
+		{ID: 1, Name: "alice", PostTitle: "50% off", Age: 30},
+		{ID: 2, Name: "bob", PostTitle: "500 offers", Age: 20, DeletedAt: &deleted},
+		{ID: 3, Name: "a_b", PostTitle: "go", Age: 40},
+		{ID: 4, Name: "axb", PostTitle: "rust", Age: 50},
+	}
+	if err := db.Table("t").Create(&rows).Error; err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		filter string
+		want   []int64
+	}{
+		{filter: "title~50%", want: []int64{1}},
+		{filter: "title~50", want: []int64{1, 2}},
+		{filter: "name~a_b", want: []int64{3}},
+		{filter: "name~_", want: []int64{3}},
+		{filter: "deletedAt=null", want: []int64{1, 3, 4}},
+		{filter: "deletedAt!=null", want: []int64{2}},
+		{filter: "name=alice OR name=bob AND age>25", want: []int64{1}},
+		{filter: "(name=alice OR name=bob) AND age<25", want: []int64{2}},
+		{filter: "age>=40 AND (name~x OR title=go)", want: []int64{3, 4}},
+	}
+
+	for _, tt := range tests {
+		expr, err := testSchema.Filter(tt.filter)
+		if err != nil {
+			t.Fatalf("Filter(%q) error = %v", tt.filter, err)
+		}
+
+		var ids []int64
+		if err := db.Table("t").Where(expr).Order("id").Pluck("id", &ids).Error; err != nil {
+			t.Fatalf("Filter(%q) query error = %v", tt.filter, err)
+		}
+		if !reflect.DeepEqual(ids, tt.want) {
+			t.Errorf("Filter(%q) matched %v, want %v", tt.filter, ids, tt.want)
+		}
+	}
+}
+
+func TestOrderBy(t *testing.T) {
+	tests := []struct {
+		orderBy   string
+		wantField string
+		wantOrder string
+		wantErr   string
+	}{
+		{orderBy: "age", wantField: "age"},
+		{orderBy: "age asc", wantField: "age", wantOrder: "asc"},
+		{orderBy: "  createdAt   DESC ", wantField: "createdAt", wantOrder: "desc"},
+		{orderBy: "", wantErr: "must be in the form"},
+		{orderBy: "age desc extra", wantErr: "must be in the form"},
+		{orderBy: "unknown", wantErr: `field "unknown" is not sortable`},
+		{orderBy: "name", wantErr: `field "name" is not sortable`},
+		{orderBy: "deletedAt desc", wantErr: `field "deletedAt" is not sortable`},
+		{orderBy: "created_at", wantErr: "is not sortable"},
+		{orderBy: "age up", wantErr: `invalid order "up"`},
+		{orderBy: "age; DROP", wantErr: "is not sortable"},
+	}
+
+	for _, tt := range tests {
+		field, order, err := testSchema.OrderBy(tt.orderBy)
+		if tt.wantErr != "" {
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("OrderBy(%q) error = %v, want error containing %q", tt.orderBy, err, tt.wantErr)
+			}
+			continue
+		}
+		if err != nil || field != tt.wantField || order != tt.wantOrder {
+			t.Errorf("OrderBy(%q) = %q, %q, %v, want %q, %q", tt.orderBy, field, order, err, tt.wantField, tt.wantOrder)
+		}
+	}
+}
+
+func TestSchemaWith(t *testing.T) {
+	trashed := testSchema.With(map[string]Field{"removedAt": {Column: "removed_at", Type: Time, Sortable: true}})
+
+	if _, ok := testSchema.Fields["removedAt"]; ok {
+		t.Error("With() modified the base schema")
+	}
+	if got, want := trashed.Sortable(), []string{"age", "createdAt", "removedAt"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("Sortable() = %v, want %v", got, want)
+	}
+	if trashed.DefaultSort != testSchema.DefaultSort || trashed.DefaultDesc != testSchema.DefaultDesc {
+		t.Error("With() did not keep the default sort")
+	}
+}
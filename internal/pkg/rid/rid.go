@@ -13,6 +13,10 @@ const (
 	UserID ResourceID = "user"
 	// PostID 定义博文资源标识符.
 	PostID ResourceID = "post"
+	// CategoryID 定义分类资源标识符.
+	CategoryID ResourceID = "category"
+	// CommentID 定义评论资源标识符.
+	CommentID ResourceID = "comment"
 )
 
 // String 将资源标识符转换为字符串.
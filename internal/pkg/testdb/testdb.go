@@ -0,0 +1,28 @@
+// Package testdb 提供单元测试使用的 SQLite 内存数据库.
+package testdb // import "github.com/onexstack/fastgo/internal/pkg/testdb"
+
+import (
+	"testing"
+
+	"gorm.io/gorm"
+
+	genericoptions "github.com/onexstack/fastgo/pkg/options"
+)
+
+// New 使用与 memory 存储后端相同的配置打开一个 SQLite 内存数据库，测试结束时自动关闭.
+// 每次调用返回的都是一个新的空数据库.
+func New(tb testing.TB) *gorm.DB {
+	tb.Helper()
+
+	db, err := genericoptions.NewMemoryOptions().NewDB()
+	if err != nil {
+		tb.Fatalf("failed to open memory database: %v", err)
+	}
+	tb.Cleanup(func() {
+		if sqlDB, err := db.DB(); err == nil {
+			_ = sqlDB.Close()
+		}
+	})
+
+	return db
+}
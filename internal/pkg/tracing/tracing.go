@@ -0,0 +1,51 @@
+// Package tracing 封装了 fg-apiserver 使用的 OpenTelemetry 链路追踪功能.
+// span 通过 context.Context 在 handler、biz 和 store 层之间传递，未配置导出时使用全局的空实现，开销可以忽略.
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// instrumentationName 是创建 span 时使用的 Tracer 名称.
+const instrumentationName = "github.com/onexstack/fastgo"
+
+func init() {
+	// 始终使用 W3C Trace Context 传播，即使不导出 span，也会把上游的 trace ID 透传到日志和下游请求中
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+}
+
+// Start 创建一个 span，返回包含该 span 的上下文. 调用方需要在操作结束时调用 span.End().
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, opts...)
+}
+
+// RecordError 将 err 记录到 span 中，并将 span 的状态设置为错误. err 为 nil 时不做任何处理.
+func RecordError(span trace.Span, err error) {
+	if err == nil {
+		return
+	}
+
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+// TraceID 返回上下文中 span 的 trace ID，没有 span 时返回空字符串.
+func TraceID(ctx context.Context) string {
+	if sc := trace.SpanContextFromContext(ctx); sc.HasTraceID() {
+		return sc.TraceID().String()
+	}
+	return ""
+}
+
+// SpanID 返回上下文中 span 的 span ID，没有 span 时返回空字符串.
+func SpanID(ctx context.Context) string {
+	if sc := trace.SpanContextFromContext(ctx); sc.HasSpanID() {
+		return sc.SpanID().String()
+	}
+	return ""
+}
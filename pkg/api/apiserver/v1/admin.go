@@ -0,0 +1,29 @@
+package v1
+
+// GetLogLevelRequest 表示查询日志级别请求
+type GetLogLevelRequest struct {
+}
+
+// GetLogLevelResponse 表示查询日志级别响应
+type GetLogLevelResponse struct {
+	// level 表示默认的日志级别
+	Level string `json:"level"`
+	// levels 表示按包覆盖的日志级别，键为包路径
+	Levels map[string]string `json:"levels"`
+}
+
+// UpdateLogLevelRequest 表示修改日志级别请求，只对接收请求的实例生效，重启或收到 SIGHUP 信号后恢复为配置文件中的日志级别
+type UpdateLogLevelRequest struct {
+	// level 表示默认的日志级别，可选值为 debug、info、warn、error，不传时保留当前配置
+	Level string `json:"level,omitempty"`
+	// levels 表示按包覆盖的日志级别，不传时保留当前配置，传入空对象时清空
+	Levels map[string]string `json:"levels,omitempty"`
+}
+
+// UpdateLogLevelResponse 表示修改日志级别响应
+type UpdateLogLevelResponse struct {
+	// level 表示修改后默认的日志级别
+	Level string `json:"level"`
+	// levels 表示修改后按包覆盖的日志级别
+	Levels map[string]string `json:"levels"`
+}
@@ -0,0 +1,59 @@
+package v1
+
+import (
+	"time"
+)
+
+// Category 表示博客分类
+type Category struct {
+	// categoryID 表示分类 ID
+	CategoryID string `json:"categoryID"`
+	// parentID 表示父分类 ID，顶级分类为空
+	ParentID string `json:"parentID"`
+	// name 表示分类名称
+	Name string `json:"name"`
+	// createdAt 表示分类创建时间
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// CreateCategoryRequest 表示创建分类请求
+type CreateCategoryRequest struct {
+	// name 表示分类名称
+	Name string `json:"name"`
+	// parentID 表示父分类 ID，为空时创建顶级分类
+	ParentID string `json:"parentID"`
+}
+
+// CreateCategoryResponse 表示创建分类响应
+type CreateCategoryResponse struct {
+	// categoryID 表示创建的分类 ID
+	CategoryID string `json:"categoryID"`
+}
+
+// DeleteCategoryRequest 表示删除分类请求
+type DeleteCategoryRequest struct {
+	// categoryID 表示要删除的分类 ID，对应 {categoryID}
+	CategoryID string `json:"categoryID" uri:"categoryID"`
+}
+
+// DeleteCategoryResponse 表示删除分类响应
+type DeleteCategoryResponse struct {
+}
+
+// ListCategoryRequest 表示获取分类列表请求
+type ListCategoryRequest struct {
+	// PageRequest 表示分页参数，sortBy 可选值：path（默认，正序）、name、createdAt、updatedAt
+	PageRequest
+	// QueryRequest 表示过滤、排序和字段选择参数
+	QueryRequest
+}
+
+// ListCategoryResponse 表示获取分类列表响应
+type ListCategoryResponse struct {
+	// total_count 表示分类总数，只有请求中 withTotal 为 true 时返回
+	TotalCount *int64 `json:"total_count,omitempty"`
+	// categories 表示分类列表，默认按照分类路径排列，子分类紧跟在父分类之后
+	Categories []*Category `json:"categories"`
+	// nextPageToken 表示获取下一页使用的分页令牌，为空表示没有更多数据
+	NextPageToken string `json:"nextPageToken,omitempty"`
+}
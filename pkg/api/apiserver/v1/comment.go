@@ -0,0 +1,87 @@
+package v1
+
+import (
+	"time"
+)
+
+// Comment 表示博客评论
+type Comment struct {
+	// commentID 表示评论 ID
+	CommentID string `json:"commentID"`
+	// postID 表示评论所属的博文 ID
+	PostID string `json:"postID"`
+	// parentID 表示回复的评论 ID，顶级评论为空
+	ParentID string `json:"parentID"`
+	// userID 表示评论者的用户 ID，已删除的评论为空
+	UserID string `json:"userID"`
+	// content 表示评论内容，已删除的评论为 "[deleted]"
+	Content string `json:"content"`
+	// deleted 表示评论是否已被删除
+	Deleted bool `json:"deleted"`
+	// createdAt 表示评论创建时间
+	CreatedAt time.Time `json:"createdAt"`
+	// updatedAt 表示评论最后更新时间
+	UpdatedAt time.Time `json:"updatedAt"`
+}
+
+// CreateCommentRequest 表示创建评论请求
+type CreateCommentRequest struct {
+	// postID 表示评论的博文 ID，对应 {postID}
+	PostID string `json:"-" uri:"postID"`
+	// parentID 表示回复的评论 ID，为空时创建顶级评论
+	ParentID string `json:"parentID"`
+	// content 表示评论内容
+	Content string `json:"content"`
+}
+
+// CreateCommentResponse 表示创建评论响应
+type CreateCommentResponse struct {
+	// commentID 表示创建的评论 ID
+	CommentID string `json:"commentID"`
+}
+
+// UpdateCommentRequest 表示编辑评论请求
+type UpdateCommentRequest struct {
+	// postID 表示评论所属的博文 ID，对应 {postID}
+	PostID string `json:"-" uri:"postID"`
+	// commentID 表示要编辑的评论 ID，对应 {commentID}
+	CommentID string `json:"-" uri:"commentID"`
+	// content 表示编辑后的评论内容
+	Content string `json:"content"`
+}
+
+// UpdateCommentResponse 表示编辑评论响应
+type UpdateCommentResponse struct {
+}
+
+// DeleteCommentRequest 表示删除评论请求
+type DeleteCommentRequest struct {
+	// postID 表示评论所属的博文 ID，对应 {postID}
+	PostID string `json:"-" uri:"postID"`
+	// commentID 表示要删除的评论 ID，对应 {commentID}
+	CommentID string `json:"-" uri:"commentID"`
+}
+
+// DeleteCommentResponse 表示删除评论响应
+type DeleteCommentResponse struct {
+}
+
+// ListCommentRequest 表示获取评论列表请求
+type ListCommentRequest struct {
+	// postID 表示博文 ID，对应 {postID}
+	PostID string `json:"-" uri:"postID"`
+	// PageRequest 表示分页参数，sortBy 可选值：createdAt（默认，正序）、updatedAt
+	PageRequest
+	// QueryRequest 表示过滤、排序和字段选择参数
+	QueryRequest
+}
+
+// ListCommentResponse 表示获取评论列表响应
+type ListCommentResponse struct {
+	// total_count 表示总评论数，只有请求中 withTotal 为 true 时返回
+	TotalCount *int64 `json:"total_count,omitempty"`
+	// comments 表示评论列表，默认按照创建顺序排列，通过 parentID 组织成评论树
+	Comments []*Comment `json:"comments"`
+	// nextPageToken 表示获取下一页使用的分页令牌，为空表示没有更多数据
+	NextPageToken string `json:"nextPageToken,omitempty"`
+}
@@ -0,0 +1,26 @@
+package v1
+
+// PageRequest 表示列表接口通用的分页参数
+type PageRequest struct {
+	// pageToken 表示上一页响应中的 nextPageToken，为空时从第一页开始. 翻页时其它查询参数需要与第一页保持一致
+	PageToken string `json:"pageToken" form:"pageToken"`
+	// limit 表示每页数量，默认 20，最大 100
+	Limit int64 `json:"limit" form:"limit"`
+	// sortBy 表示排序字段，可选值取决于具体的列表接口，例如 createdAt、updatedAt
+	SortBy string `json:"sortBy" form:"sortBy"`
+	// order 表示排序方向，可选值：asc、desc
+	Order string `json:"order" form:"order"`
+	// withTotal 表示是否返回总数，统计总数需要额外的查询，默认不返回
+	WithTotal bool `json:"withTotal" form:"withTotal"`
+}
+
+// QueryRequest 表示列表接口通用的过滤、排序和字段选择参数
+type QueryRequest struct {
+	// filter 表示过滤条件，例如 createdAt>2024-01-01 AND title~go，支持 = != > >= < <= ~（包含）、AND、OR 和括号，
+	// 可用的字段取决于具体的列表接口
+	Filter string `json:"filter" form:"filter"`
+	// orderBy 表示排序方式，格式为 "字段 [asc|desc]"，例如 updatedAt desc，不能与 sortBy、order 同时使用
+	OrderBy string `json:"orderBy" form:"orderBy"`
+	// fields 表示以逗号分隔的返回字段，例如 postID,title，为空时返回所有字段
+	Fields string `json:"fields" form:"fields"`
+}
@@ -0,0 +1,65 @@
+package v1
+
+import (
+	"time"
+)
+
+// Policy 表示授权策略
+type Policy struct {
+	// role 表示策略所属的角色
+	Role string `json:"role"`
+	// path 表示路由路径，* 表示所有路径，以 * 结尾表示前缀匹配
+	Path string `json:"path"`
+	// method 表示 HTTP 方法，* 表示所有方法
+	Method string `json:"method"`
+	// createdAt 表示策略创建时间
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// CreatePolicyRequest 表示创建授权策略请求
+type CreatePolicyRequest struct {
+	// role 表示策略所属的角色
+	Role string `json:"role"`
+	// path 表示路由路径
+	Path string `json:"path"`
+	// method 表示 HTTP 方法
+	Method string `json:"method"`
+}
+
+// CreatePolicyResponse 表示创建授权策略响应
+type CreatePolicyResponse struct {
+}
+
+// DeletePolicyRequest 表示删除授权策略请求
+type DeletePolicyRequest struct {
+	// role 表示策略所属的角色
+	Role string `json:"role"`
+	// path 表示路由路径
+	Path string `json:"path"`
+	// method 表示 HTTP 方法
+	Method string `json:"method"`
+}
+
+// DeletePolicyResponse 表示删除授权策略响应
+type DeletePolicyResponse struct {
+}
+
+// ListPolicyRequest 表示获取授权策略列表请求
+type ListPolicyRequest struct {
+	// role 表示可选的角色过滤
+	Role *string `json:"role" form:"role"`
+	// PageRequest 表示分页参数，sortBy 可选值：createdAt（默认）、updatedAt
+	PageRequest
+	// QueryRequest 表示过滤、排序和字段选择参数
+	QueryRequest
+}
+
+// ListPolicyResponse 表示获取授权策略列表响应
+type ListPolicyResponse struct {
+	// totalCount 表示总策略数，只有请求中 withTotal 为 true 时返回
+	TotalCount *int64 `json:"totalCount,omitempty"`
+	// policies 表示策略列表
+	Policies []*Policy `json:"policies"`
+	// nextPageToken 表示获取下一页使用的分页令牌，为空表示没有更多数据
+	NextPageToken string `json:"nextPageToken,omitempty"`
+}
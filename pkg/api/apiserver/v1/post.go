@@ -14,18 +14,42 @@ type Post struct {
 	Title string `json:"title"`
 	// content 表示博客内容
 	Content string `json:"content"`
+	// categoryID 表示博客所属的分类 ID
+	CategoryID string `json:"categoryID"`
+	// tags 表示博客的标签列表
+	Tags []string `json:"tags"`
+	// status 表示博客状态，可选值为 draft、scheduled、published、archived
+	Status string `json:"status"`
+	// visibility 表示博客可见性，可选值为 private、unlisted、public
+	Visibility string `json:"visibility"`
+	// publishAt 表示博客的定时发布时间
+	PublishAt *time.Time `json:"publishAt,omitempty"`
+	// publishedAt 表示博客的发布时间
+	PublishedAt *time.Time `json:"publishedAt,omitempty"`
+	// version 表示博客的版本号，每次更新加 1，同时通过 ETag 响应头返回
+	Version int64 `json:"version"`
+	// deletedAt 表示博客被删除的时间，只有回收站中的博客才有该字段
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
 	// createdAt 表示博客创建时间
 	CreatedAt time.Time `json:"createdAt"`
 	// updatedAt 表示博客最后更新时间
 	UpdatedAt time.Time `json:"updatedAt"`
 }
 
-// CreatePostRequest 表示创建文章请求
+// CreatePostRequest 表示创建文章请求，新创建的文章为草稿，设置 publishAt 时为定时发布
 type CreatePostRequest struct {
 	// title 表示博客标题
 	Title string `json:"title"`
 	// content 表示博客内容
 	Content string `json:"content"`
+	// categoryID 表示博客所属的分类 ID
+	CategoryID string `json:"categoryID"`
+	// tags 表示博客的标签列表，不存在的标签会被自动创建
+	Tags []string `json:"tags"`
+	// visibility 表示博客可见性，默认为 private
+	Visibility *string `json:"visibility"`
+	// publishAt 表示博客的定时发布时间，必须晚于当前时间
+	PublishAt *time.Time `json:"publishAt"`
 }
 
 // CreatePostResponse 表示创建文章响应
@@ -42,10 +66,20 @@ type UpdatePostRequest struct {
 	Title *string `json:"title"`
 	// content 表示更新后的博客内容
 	Content *string `json:"content"`
+	// categoryID 表示更新后的分类 ID，为空字符串时取消分类
+	CategoryID *string `json:"categoryID"`
+	// tags 表示更新后的标签列表，会替换原有的全部标签
+	Tags *[]string `json:"tags"`
+	// visibility 表示更新后的博客可见性
+	Visibility *string `json:"visibility"`
+	// version 表示客户端读取到的博客版本号，来自 If-Match 请求头，不为空时版本号不一致会拒绝更新
+	Version *int64 `json:"-"`
 }
 
 // UpdatePostResponse 表示更新文章响应
 type UpdatePostResponse struct {
+	// version 表示更新后的博客版本号
+	Version int64 `json:"version"`
 }
 
 // DeletePostRequest 表示删除文章请求
@@ -72,18 +106,162 @@ type GetPostResponse struct {
 
 // ListPostRequest 表示获取文章列表请求
 type ListPostRequest struct {
-	// offset 表示偏移量
-	Offset int64 `json:"offset"`
-	// limit 表示每页数量
-	Limit int64 `json:"limit"`
+	// PageRequest 表示分页参数，sortBy 可选值：createdAt（默认）、updatedAt
+	PageRequest
+	// QueryRequest 表示过滤、排序和字段选择参数
+	QueryRequest
 	// title 表示可选的标题过滤
 	Title *string `json:"title"`
+	// status 表示可选的博客状态过滤
+	Status *string `json:"status" form:"status"`
+	// tags 表示可选的标签过滤
+	Tags []string `json:"tags" form:"tags"`
+	// tagMode 表示标签过滤方式，any 表示包含任意一个标签，all 表示包含所有标签，默认为 any
+	TagMode string `json:"tagMode" form:"tagMode"`
+	// categoryID 表示可选的分类过滤，包含该分类下所有子分类中的博客
+	CategoryID *string `json:"categoryID" form:"categoryID"`
 }
 
 // ListPostResponse 表示获取文章列表响应
 type ListPostResponse struct {
-	// total_count 表示总文章数
-	TotalCount int64 `json:"total_count"`
+	// total_count 表示总文章数，只有请求中 withTotal 为 true 时返回
+	TotalCount *int64 `json:"total_count,omitempty"`
 	// posts 表示文章列表
 	Posts []*Post `json:"posts"`
+	// nextPageToken 表示获取下一页使用的分页令牌，为空表示没有更多数据
+	NextPageToken string `json:"nextPageToken,omitempty"`
+}
+
+// PublishPostRequest 表示发布文章请求
+type PublishPostRequest struct {
+	// postID 表示要发布的文章 ID，对应 {postID}
+	PostID string `json:"postID" uri:"postID"`
+	// publishAt 表示定时发布时间，为空或早于当前时间时立即发布
+	PublishAt *time.Time `json:"publishAt"`
+}
+
+// PublishPostResponse 表示发布文章响应
+type PublishPostResponse struct {
+	// post 表示发布后的文章信息
+	Post *Post `json:"post"`
+}
+
+// UnpublishPostRequest 表示撤回文章请求
+type UnpublishPostRequest struct {
+	// postID 表示要撤回的文章 ID，对应 {postID}
+	PostID string `json:"postID" uri:"postID"`
+	// archive 为 true 时将文章归档，否则将文章改回草稿
+	Archive bool `json:"archive"`
+}
+
+// UnpublishPostResponse 表示撤回文章响应
+type UnpublishPostResponse struct {
+	// post 表示撤回后的文章信息
+	Post *Post `json:"post"`
+}
+
+// PublicPost 表示公开展示的博客文章，只包含可以公开的信息
+type PublicPost struct {
+	// postID 表示博文 ID
+	PostID string `json:"postID"`
+	// author 表示作者的用户名
+	Author string `json:"author"`
+	// title 表示博客标题
+	Title string `json:"title"`
+	// content 表示博客内容
+	Content string `json:"content"`
+	// publishedAt 表示博客的发布时间
+	PublishedAt time.Time `json:"publishedAt"`
+}
+
+// ListPublicPostRequest 表示获取公开文章列表请求
+type ListPublicPostRequest struct {
+	// PageRequest 表示分页参数，只支持按照发布时间倒序排列，sortBy 可选值：publishedAt（默认）
+	PageRequest
+	// QueryRequest 表示过滤、排序和字段选择参数
+	QueryRequest
+	// author 表示可选的作者用户名过滤，对应 {username}
+	Author string `json:"author" form:"author" uri:"username"`
+}
+
+// ListPublicPostResponse 表示获取公开文章列表响应
+type ListPublicPostResponse struct {
+	// totalCount 表示公开文章总数，只有请求中 withTotal 为 true 时返回
+	TotalCount *int64 `json:"totalCount,omitempty"`
+	// posts 表示文章列表
+	Posts []*PublicPost `json:"posts"`
+	// nextPageToken 表示获取下一页使用的分页令牌，为空表示没有更多数据
+	NextPageToken string `json:"nextPageToken,omitempty"`
+}
+
+// GetPublicPostRequest 表示获取公开文章请求
+type GetPublicPostRequest struct {
+	// postID 表示要获取的文章 ID
+	PostID string `json:"postID" uri:"postID"`
+}
+
+// GetPublicPostResponse 表示获取公开文章响应
+type GetPublicPostResponse struct {
+	// post 表示返回的文章信息
+	Post *PublicPost `json:"post"`
+}
+
+// ListTrashPostRequest 表示获取回收站中的文章列表请求
+type ListTrashPostRequest struct {
+	// PageRequest 表示分页参数，sortBy 可选值：deletedAt（默认）、createdAt、updatedAt
+	PageRequest
+	// QueryRequest 表示过滤、排序和字段选择参数
+	QueryRequest
+}
+
+// ListTrashPostResponse 表示获取回收站中的文章列表响应
+type ListTrashPostResponse struct {
+	// total_count 表示回收站中的总文章数，只有请求中 withTotal 为 true 时返回
+	TotalCount *int64 `json:"total_count,omitempty"`
+	// posts 表示文章列表，默认按照删除时间倒序排列
+	Posts []*Post `json:"posts"`
+	// nextPageToken 表示获取下一页使用的分页令牌，为空表示没有更多数据
+	NextPageToken string `json:"nextPageToken,omitempty"`
+}
+
+// RestorePostRequest 表示从回收站恢复文章请求
+type RestorePostRequest struct {
+	// postID 表示要恢复的文章 ID，对应 {postID}
+	PostID string `json:"postID" uri:"postID"`
+}
+
+// RestorePostResponse 表示从回收站恢复文章响应
+type RestorePostResponse struct {
+	// post 表示恢复后的文章信息
+	Post *Post `json:"post"`
+}
+
+// SearchPostRequest 表示全文检索文章请求，检索范围与文章列表相同
+type SearchPostRequest struct {
+	// q 表示检索关键词，在标题和内容中检索，标题匹配的权重更高
+	Q string `json:"q" form:"q"`
+	// pageToken 表示上一页响应中的 nextPageToken，为空时从第一页开始. 翻页时 q 需要与第一页保持一致
+	PageToken string `json:"pageToken" form:"pageToken"`
+	// limit 表示每页数量，默认 20，最大 100
+	Limit int64 `json:"limit" form:"limit"`
+}
+
+// PostSearchResult 表示一条全文检索结果
+type PostSearchResult struct {
+	// post 表示匹配的文章信息
+	Post *Post `json:"post"`
+	// score 表示相关度评分，结果按照评分倒序排列
+	Score float64 `json:"score"`
+	// highlights 表示 title、content 中匹配关键词的片段，关键词使用 <mark> 标记，其余内容已做 HTML 转义
+	Highlights map[string][]string `json:"highlights"`
+}
+
+// SearchPostResponse 表示全文检索文章响应
+type SearchPostResponse struct {
+	// totalCount 表示匹配的总文章数
+	TotalCount int64 `json:"totalCount"`
+	// results 表示按照相关度排列的检索结果
+	Results []*PostSearchResult `json:"results"`
+	// nextPageToken 表示获取下一页使用的分页令牌，为空表示没有更多数据
+	NextPageToken string `json:"nextPageToken,omitempty"`
 }
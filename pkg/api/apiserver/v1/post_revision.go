@@ -0,0 +1,101 @@
+package v1
+
+import (
+	"time"
+)
+
+// PostRevision 表示博客的一个修订版本
+type PostRevision struct {
+	// postID 表示博文 ID
+	PostID string `json:"postID"`
+	// revision 表示修订版本号，同一篇博文内从 1 开始递增
+	Revision int64 `json:"revision"`
+	// userID 表示修改者的用户 ID
+	UserID string `json:"userID"`
+	// title 表示该版本的博文标题
+	Title string `json:"title"`
+	// content 表示该版本的博文内容，列表接口不返回内容
+	Content string `json:"content,omitempty"`
+	// createdAt 表示版本创建时间
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// DiffLine 表示修订版本差异中的一行文本
+type DiffLine struct {
+	// op 表示该行的操作类型，可选值为 equal、insert、delete
+	Op string `json:"op"`
+	// text 表示该行文本，不包含换行符
+	Text string `json:"text"`
+}
+
+// ListPostRevisionRequest 表示获取博文修订版本列表请求
+type ListPostRevisionRequest struct {
+	// postID 表示博文 ID，对应 {postID}
+	PostID string `json:"-" uri:"postID"`
+	// PageRequest 表示分页参数，sortBy 可选值：revision（默认，倒序）、createdAt
+	PageRequest
+	// QueryRequest 表示过滤、排序和字段选择参数
+	QueryRequest
+}
+
+// ListPostRevisionResponse 表示获取博文修订版本列表响应
+type ListPostRevisionResponse struct {
+	// total_count 表示总修订版本数，只有请求中 withTotal 为 true 时返回
+	TotalCount *int64 `json:"total_count,omitempty"`
+	// revisions 表示修订版本列表，默认按照版本号倒序排列
+	Revisions []*PostRevision `json:"revisions"`
+	// nextPageToken 表示获取下一页使用的分页令牌，为空表示没有更多数据
+	NextPageToken string `json:"nextPageToken,omitempty"`
+}
+
+// GetPostRevisionRequest 表示获取博文修订版本详情请求
+type GetPostRevisionRequest struct {
+	// postID 表示博文 ID，对应 {postID}
+	PostID string `json:"-" uri:"postID"`
+	// revision 表示修订版本号，对应 {revision}
+	Revision int64 `json:"-" uri:"revision"`
+}
+
+// GetPostRevisionResponse 表示获取博文修订版本详情响应
+type GetPostRevisionResponse struct {
+	// revision 表示修订版本详情
+	Revision *PostRevision `json:"revision"`
+}
+
+// DiffPostRevisionRequest 表示比较博文两个修订版本请求
+type DiffPostRevisionRequest struct {
+	// postID 表示博文 ID，对应 {postID}
+	PostID string `json:"-" uri:"postID"`
+	// from 表示作为比较基准的旧版本号
+	From int64 `json:"from" form:"from"`
+	// to 表示要比较的新版本号
+	To int64 `json:"to" form:"to"`
+}
+
+// DiffPostRevisionResponse 表示比较博文两个修订版本响应
+type DiffPostRevisionResponse struct {
+	// from 表示作为比较基准的旧版本号
+	From int64 `json:"from"`
+	// to 表示要比较的新版本号
+	To int64 `json:"to"`
+	// title 表示标题的逐行差异
+	Title []*DiffLine `json:"title"`
+	// content 表示内容的逐行差异
+	Content []*DiffLine `json:"content"`
+}
+
+// RestorePostRevisionRequest 表示恢复博文修订版本请求
+type RestorePostRevisionRequest struct {
+	// postID 表示博文 ID，对应 {postID}
+	PostID string `json:"-" uri:"postID"`
+	// revision 表示要恢复的修订版本号，对应 {revision}
+	Revision int64 `json:"-" uri:"revision"`
+}
+
+// RestorePostRevisionResponse 表示恢复博文修订版本响应
+type RestorePostRevisionResponse struct {
+	// post 表示恢复后的文章信息
+	Post *Post `json:"post"`
+	// revision 表示恢复操作生成的新修订版本号
+	Revision int64 `json:"revision"`
+}
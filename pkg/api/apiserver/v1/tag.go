@@ -0,0 +1,51 @@
+package v1
+
+// Tag 表示博客标签
+type Tag struct {
+	// name 表示标签名称
+	Name string `json:"name"`
+	// postCount 表示关联该标签的博客数量
+	PostCount int64 `json:"postCount"`
+}
+
+// ListTagRequest 表示获取标签列表请求
+type ListTagRequest struct {
+	// PageRequest 表示分页参数，sortBy 可选值：name（默认，正序）、createdAt、updatedAt
+	PageRequest
+	// QueryRequest 表示过滤、排序和字段选择参数
+	QueryRequest
+}
+
+// ListTagResponse 表示获取标签列表响应
+type ListTagResponse struct {
+	// total_count 表示标签总数，只有请求中 withTotal 为 true 时返回
+	TotalCount *int64 `json:"total_count,omitempty"`
+	// tags 表示标签列表
+	Tags []*Tag `json:"tags"`
+	// nextPageToken 表示获取下一页使用的分页令牌，为空表示没有更多数据
+	NextPageToken string `json:"nextPageToken,omitempty"`
+}
+
+// RenameTagRequest 表示重命名标签请求
+type RenameTagRequest struct {
+	// name 表示要重命名的标签名称，对应 {name}
+	Name string `json:"-" uri:"name"`
+	// newName 表示新的标签名称，新名称已存在时应使用合并标签接口
+	NewName string `json:"newName"`
+}
+
+// RenameTagResponse 表示重命名标签响应
+type RenameTagResponse struct {
+}
+
+// MergeTagRequest 表示合并标签请求
+type MergeTagRequest struct {
+	// sources 表示要合并的标签名称列表，合并后这些标签会被删除
+	Sources []string `json:"sources"`
+	// target 表示合并到的标签名称，不存在时会自动创建
+	Target string `json:"target"`
+}
+
+// MergeTagResponse 表示合并标签响应
+type MergeTagResponse struct {
+}
@@ -16,8 +16,16 @@ type User struct {
 	Email string `json:"email"`
 	// phone 表示用户手机号
 	Phone string `json:"phone"`
+	// role 表示用户角色
+	Role string `json:"role"`
 	// postCount 表示用户拥有的博客数量
 	PostCount int64 `json:"postCount"`
+	// version 表示用户信息的版本号，每次更新加 1，同时通过 ETag 响应头返回
+	Version int64 `json:"version"`
+	// deletionScheduledAt 表示账号计划删除的时间，只有申请删除账号后才有该字段
+	DeletionScheduledAt *time.Time `json:"deletionScheduledAt,omitempty"`
+	// deletedAt 表示用户被删除的时间，只有回收站中的用户才有该字段
+	DeletedAt *time.Time `json:"deletedAt,omitempty"`
 	// createdAt 表示用户注册时间
 	CreatedAt time.Time `json:"createdAt"`
 	// updatedAt 表示用户最后更新时间
@@ -33,18 +41,28 @@ type LoginRequest struct {
 
 // LoginResponse 表示登录响应
 type LoginResponse struct {
+	// userID 表示登录用户的 ID
+	UserID string `json:"userID"`
 	// token 表示 JWT Token
 	Token string `json:"token"`
 	// expireAt 表示 token 过期时间
 	ExpireAt time.Time `json:"expireAt"`
+	// refreshToken 表示用于换取新 token 的刷新令牌
+	RefreshToken string `json:"refreshToken"`
+	// refreshExpireAt 表示刷新令牌过期时间
+	RefreshExpireAt time.Time `json:"refreshExpireAt"`
 }
 
 // RefreshTokenRequest 表示刷新令牌的请求
 type RefreshTokenRequest struct {
+	// refreshToken 表示登录或上一次刷新时返回的刷新令牌
+	RefreshToken string `json:"refreshToken"`
 }
 
 // ChangePasswordRequest 表示修改密码的请求
 type ChangePasswordRequest struct {
+	// userID 表示要修改密码的用户 ID，对应 {userID}，只能是当前用户
+	UserID string `json:"-" uri:"userID"`
 	// oldPassword 表示旧密码
 	OldPassword string `json:"oldPassword"`
 	// newPassword 表示新密码
@@ -61,6 +79,26 @@ type RefreshTokenResponse struct {
 	Token string `json:"token"`
 	// expireAt 表示 token 过期时间
 	ExpireAt time.Time `json:"expireAt"`
+	// refreshToken 表示轮换后的新刷新令牌，旧的刷新令牌立即失效
+	RefreshToken string `json:"refreshToken"`
+	// refreshExpireAt 表示新刷新令牌过期时间
+	RefreshExpireAt time.Time `json:"refreshExpireAt"`
+}
+
+// LogoutRequest 表示退出当前会话的请求
+type LogoutRequest struct {
+}
+
+// LogoutResponse 表示退出当前会话的响应
+type LogoutResponse struct {
+}
+
+// LogoutAllRequest 表示退出所有会话的请求
+type LogoutAllRequest struct {
+}
+
+// LogoutAllResponse 表示退出所有会话的响应
+type LogoutAllResponse struct {
 }
 
 // CreateUserRequest 表示创建用户请求
@@ -85,6 +123,8 @@ type CreateUserResponse struct {
 
 // UpdateUserRequest 表示更新用户请求
 type UpdateUserRequest struct {
+	// userID 表示要更新的用户 ID，对应 {userID}，仅管理员可以更新其他用户
+	UserID string `json:"userID" uri:"userID"`
 	// username 表示可选的用户名称
 	Username *string `json:"username"`
 	// nickname 表示可选的用户昵称
@@ -93,22 +133,42 @@ type UpdateUserRequest struct {
 	Email *string `json:"email"`
 	// phone 表示可选的用户手机号
 	Phone *string `json:"phone"`
+	// version 表示客户端读取到的用户版本号，来自 If-Match 请求头，不为空时版本号不一致会拒绝更新
+	Version *int64 `json:"-"`
 }
 
 // UpdateUserResponse 表示更新用户响应
 type UpdateUserResponse struct {
+	// version 表示更新后的用户版本号
+	Version int64 `json:"version"`
 }
 
 // DeleteUserRequest 表示删除用户请求
 type DeleteUserRequest struct {
+	// userID 表示要删除的用户 ID，对应 {userID}，仅管理员可以删除其他用户
+	UserID string `json:"userID" uri:"userID"`
 }
 
 // DeleteUserResponse 表示删除用户响应
 type DeleteUserResponse struct {
+	// deletionScheduledAt 表示账号计划删除的时间，在此之前可以导出数据或取消删除
+	DeletionScheduledAt time.Time `json:"deletionScheduledAt"`
+}
+
+// CancelUserDeletionRequest 表示取消删除账号请求
+type CancelUserDeletionRequest struct {
+	// userID 表示要取消删除的用户 ID，对应 {userID}，仅管理员可以操作其他用户
+	UserID string `json:"userID" uri:"userID"`
+}
+
+// CancelUserDeletionResponse 表示取消删除账号响应
+type CancelUserDeletionResponse struct {
 }
 
 // GetUserRequest 表示获取用户请求
 type GetUserRequest struct {
+	// userID 表示要获取的用户 ID，对应 {userID}，仅管理员可以获取其他用户
+	UserID string `json:"userID" uri:"userID"`
 }
 
 // GetUserResponse 表示获取用户响应
@@ -119,16 +179,87 @@ type GetUserResponse struct {
 
 // ListUserRequest 表示用户列表请求
 type ListUserRequest struct {
-	// offset 表示偏移量
-	Offset int64 `json:"offset"`
-	// limit 表示每页数量
-	Limit int64 `json:"limit"`
+	// PageRequest 表示分页参数，sortBy 可选值：createdAt（默认）、updatedAt
+	PageRequest
+	// QueryRequest 表示过滤、排序和字段选择参数
+	QueryRequest
 }
 
 // ListUserResponse 表示用户列表响应
 type ListUserResponse struct {
-	// totalCount 表示总用户数
-	TotalCount int64 `json:"totalCount"`
+	// totalCount 表示总用户数，只有请求中 withTotal 为 true 时返回
+	TotalCount *int64 `json:"totalCount,omitempty"`
 	// users 表示用户列表
 	Users []*User `json:"users"`
+	// nextPageToken 表示获取下一页使用的分页令牌，为空表示没有更多数据
+	NextPageToken string `json:"nextPageToken,omitempty"`
+}
+
+// ListTrashUserRequest 表示获取回收站中的用户列表请求
+type ListTrashUserRequest struct {
+	// PageRequest 表示分页参数，sortBy 可选值：deletedAt（默认）、createdAt、updatedAt
+	PageRequest
+	// QueryRequest 表示过滤、排序和字段选择参数
+	QueryRequest
+}
+
+// ListTrashUserResponse 表示获取回收站中的用户列表响应
+type ListTrashUserResponse struct {
+	// totalCount 表示回收站中的总用户数，只有请求中 withTotal 为 true 时返回
+	TotalCount *int64 `json:"totalCount,omitempty"`
+	// users 表示用户列表，默认按照删除时间倒序排列
+	Users []*User `json:"users"`
+	// nextPageToken 表示获取下一页使用的分页令牌，为空表示没有更多数据
+	NextPageToken string `json:"nextPageToken,omitempty"`
+}
+
+// RestoreUserRequest 表示从回收站恢复用户请求
+type RestoreUserRequest struct {
+	// userID 表示要恢复的用户 ID，对应 {userID}
+	UserID string `json:"userID" uri:"userID"`
+}
+
+// RestoreUserResponse 表示从回收站恢复用户响应
+type RestoreUserResponse struct {
+	// user 表示恢复后的用户信息
+	User *User `json:"user"`
+}
+
+// ExportUserRequest 表示导出用户数据请求
+type ExportUserRequest struct {
+	// userID 表示要导出数据的用户 ID，对应 {userID}，仅管理员可以导出其他用户
+	UserID string `json:"userID" uri:"userID"`
+	// format 表示导出格式，可选值为 json、zip，默认为 json
+	Format string `json:"format" form:"format"`
+}
+
+// ExportUserResponse 表示导出用户数据响应，包含用户的所有个人数据
+type ExportUserResponse struct {
+	// exportedAt 表示导出时间
+	ExportedAt time.Time `json:"exportedAt"`
+	// user 表示用户信息
+	User *User `json:"user"`
+	// posts 表示用户的所有博客，包括回收站中的博客
+	Posts []*ExportedPost `json:"posts"`
+	// comments 表示用户发表的所有评论
+	Comments []*Comment `json:"comments"`
+}
+
+// ExportedPost 表示导出数据中的博客，包含博客的全部修订历史
+type ExportedPost struct {
+	Post
+	// revisions 表示博客的修订版本列表，按照版本号倒序排列
+	Revisions []*PostRevision `json:"revisions"`
+}
+
+// UpdateUserRoleRequest 表示修改用户角色请求
+type UpdateUserRoleRequest struct {
+	// userID 表示要修改角色的用户 ID，对应 {userID}
+	UserID string `json:"userID" uri:"userID"`
+	// role 表示新的用户角色
+	Role string `json:"role"`
+}
+
+// UpdateUserRoleResponse 表示修改用户角色响应
+type UpdateUserRoleResponse struct {
 }
@@ -0,0 +1,122 @@
+package client
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+)
+
+const (
+	// defaultMaxRetries 是请求失败后的默认最大重试次数.
+	defaultMaxRetries = 3
+	// defaultMinBackoff 是第一次重试前的默认等待时间，之后每次重试翻倍.
+	defaultMinBackoff = 200 * time.Millisecond
+	// defaultMaxBackoff 是两次重试之间的默认最长等待时间.
+	defaultMaxBackoff = 5 * time.Second
+	// defaultTimeout 是单次 HTTP 请求的默认超时时间.
+	defaultTimeout = 30 * time.Second
+)
+
+// Client 是 fg-apiserver 的客户端，可以被多个 goroutine 并发使用.
+type Client struct {
+	// baseURL 是 fg-apiserver 的地址，例如 http://127.0.0.1:6666.
+	baseURL string
+	// httpClient 用于发送 HTTP 请求.
+	httpClient *http.Client
+	// maxRetries 是请求失败后的最大重试次数，0 表示不重试.
+	maxRetries int
+	// minBackoff 和 maxBackoff 是重试等待时间的范围.
+	minBackoff time.Duration
+	maxBackoff time.Duration
+	// session 保存登录凭证和令牌.
+	session *session
+}
+
+// Option 用于配置 Client.
+type Option func(*Client)
+
+// WithHTTPClient 设置发送请求使用的 HTTP 客户端.
+func WithHTTPClient(httpClient *http.Client) Option {
+	return func(c *Client) {
+		c.httpClient = httpClient
+	}
+}
+
+// WithCredentials 设置登录使用的用户名和密码，客户端会在需要时自动登录，刷新令牌失效后也会重新登录.
+func WithCredentials(username string, password string) Option {
+	return func(c *Client) {
+		c.session.username = username
+		c.session.password = password
+	}
+}
+
+// WithToken 设置已有的访问令牌和刷新令牌，例如上一次登录时保存的令牌. refreshToken 可以为空.
+// 访问令牌的过期时间未知，只有在服务端返回 401 时才会使用刷新令牌续期.
+func WithToken(token string, refreshToken string) Option {
+	return func(c *Client) {
+		c.session.token = Token{Token: token, RefreshToken: refreshToken}
+	}
+}
+
+// WithRetry 设置请求失败后的最大重试次数和重试等待时间的范围，maxRetries 为 0 时不重试.
+func WithRetry(maxRetries int, minBackoff time.Duration, maxBackoff time.Duration) Option {
+	return func(c *Client) {
+		c.maxRetries = maxRetries
+		c.minBackoff = minBackoff
+		c.maxBackoff = maxBackoff
+	}
+}
+
+// WithTokenListener 设置令牌变化时的回调函数，登录、刷新令牌和退出登录后都会调用，可以用来持久化令牌.
+// 退出登录后回调函数的参数为空.
+func WithTokenListener(listener func(token Token)) Option {
+	return func(c *Client) {
+		c.session.listener = listener
+	}
+}
+
+// New 创建 fg-apiserver 的客户端，addr 是服务的地址，例如 http://127.0.0.1:6666.
+func New(addr string, opts ...Option) (*Client, error) {
+	u, err := url.Parse(addr)
+	if err != nil || u.Scheme == "" || u.Host == "" {
+		return nil, fmt.Errorf("invalid server address '%s'", addr)
+	}
+
+	c := &Client{
+		baseURL:    strings.TrimSuffix(addr, "/"),
+		httpClient: &http.Client{Timeout: defaultTimeout},
+		maxRetries: defaultMaxRetries,
+		minBackoff: defaultMinBackoff,
+		maxBackoff: defaultMaxBackoff,
+		session:    &session{},
+	}
+	for _, opt := range opts {
+		opt(c)
+	}
+
+	if c.maxRetries < 0 {
+		return nil, fmt.Errorf("max retries cannot be negative")
+	}
+	if c.minBackoff <= 0 || c.maxBackoff < c.minBackoff {
+		return nil, fmt.Errorf("invalid retry backoff range [%s, %s]", c.minBackoff, c.maxBackoff)
+	}
+
+	return c, nil
+}
+
+// Users 返回用户相关接口的客户端.
+func (c *Client) Users() UserClient {
+	return &users{c}
+}
+
+// Posts 返回博客相关接口的客户端.
+func (c *Client) Posts() PostClient {
+	return &posts{c}
+}
+
+// Token 返回当前的令牌，未登录时令牌为空.
+func (c *Client) Token() Token {
+	return c.session.current()
+}
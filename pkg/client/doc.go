@@ -0,0 +1,15 @@
+// Package client 是 fg-apiserver REST API 的 Go 客户端，请求和响应使用 pkg/api/apiserver/v1 中的类型.
+//
+// 客户端会自动通过 /login 获取访问令牌，并在令牌过期前通过 /refresh-token 续期；
+// 网络错误和 429、502、503、504 响应会按照指数退避重试. 接口返回的错误会被还原为 *errorsx.ErrorX：
+//
+//	c, err := client.New("http://127.0.0.1:6666", client.WithCredentials("root", "fastgo1234"))
+//	if err != nil {
+//		return err
+//	}
+//
+//	resp, err := c.Posts().Get(ctx, &v1.GetPostRequest{PostID: postID})
+//	if errx := new(errorsx.ErrorX); errors.As(err, &errx) && errx.Reason == "NotFound.PostNotFound" {
+//		// 博客不存在
+//	}
+package client
@@ -0,0 +1,188 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"strconv"
+
+	v1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+)
+
+// PostClient 定义了博客相关的接口，方法与服务端的 PostBiz 一一对应.
+type PostClient interface {
+	Create(ctx context.Context, rq *v1.CreatePostRequest) (*v1.CreatePostResponse, error)
+	// Update 更新博客，rq.Version 不为空时通过 If-Match 请求头携带版本号，版本号不一致会拒绝更新.
+	Update(ctx context.Context, rq *v1.UpdatePostRequest) (*v1.UpdatePostResponse, error)
+	Delete(ctx context.Context, rq *v1.DeletePostRequest) (*v1.DeletePostResponse, error)
+	Get(ctx context.Context, rq *v1.GetPostRequest) (*v1.GetPostResponse, error)
+	List(ctx context.Context, rq *v1.ListPostRequest) (*v1.ListPostResponse, error)
+
+	Publish(ctx context.Context, rq *v1.PublishPostRequest) (*v1.PublishPostResponse, error)
+	Unpublish(ctx context.Context, rq *v1.UnpublishPostRequest) (*v1.UnpublishPostResponse, error)
+	// ListPublic 返回已发布的公开博客，无需登录.
+	ListPublic(ctx context.Context, rq *v1.ListPublicPostRequest) (*v1.ListPublicPostResponse, error)
+	// GetPublic 返回已发布的公开或不公开列出的博客，无需登录.
+	GetPublic(ctx context.Context, rq *v1.GetPublicPostRequest) (*v1.GetPublicPostResponse, error)
+	// ListRevisions 返回博客的修订版本列表，不包含版本内容.
+	ListRevisions(ctx context.Context, rq *v1.ListPostRevisionRequest) (*v1.ListPostRevisionResponse, error)
+	// GetRevision 返回博客的指定修订版本.
+	GetRevision(ctx context.Context, rq *v1.GetPostRevisionRequest) (*v1.GetPostRevisionResponse, error)
+	// DiffRevisions 逐行比较博客的两个修订版本.
+	DiffRevisions(ctx context.Context, rq *v1.DiffPostRevisionRequest) (*v1.DiffPostRevisionResponse, error)
+	// RestoreRevision 将博客恢复为指定修订版本的标题和内容，并生成一个新的修订版本.
+	RestoreRevision(ctx context.Context, rq *v1.RestorePostRevisionRequest) (*v1.RestorePostRevisionResponse, error)
+	// ListTrash 返回回收站中的博客.
+	ListTrash(ctx context.Context, rq *v1.ListTrashPostRequest) (*v1.ListTrashPostResponse, error)
+	// Restore 将博客从回收站中恢复.
+	Restore(ctx context.Context, rq *v1.RestorePostRequest) (*v1.RestorePostResponse, error)
+	// Search 在当前用户有权查看的博客中全文检索，按照相关度排列.
+	Search(ctx context.Context, rq *v1.SearchPostRequest) (*v1.SearchPostResponse, error)
+}
+
+// posts 实现了 PostClient 接口.
+type posts struct {
+	client *Client
+}
+
+var _ PostClient = (*posts)(nil)
+
+// postPath 返回指定博客的接口路径.
+func postPath(postID string, subpaths ...string) string {
+	path := "/v1/posts/" + url.PathEscape(postID)
+	for _, subpath := range subpaths {
+		path += "/" + subpath
+	}
+	return path
+}
+
+func (p *posts) Create(ctx context.Context, rq *v1.CreatePostRequest) (*v1.CreatePostResponse, error) {
+	var resp v1.CreatePostResponse
+	if err := p.client.do(ctx, &request{method: http.MethodPost, path: "/v1/posts", body: rq}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (p *posts) Update(ctx context.Context, rq *v1.UpdatePostRequest) (*v1.UpdatePostResponse, error) {
+	var resp v1.UpdatePostResponse
+	if err := p.client.do(ctx, &request{method: http.MethodPut, path: postPath(rq.PostID), body: rq, ifMatch: rq.Version}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (p *posts) Delete(ctx context.Context, rq *v1.DeletePostRequest) (*v1.DeletePostResponse, error) {
+	var resp v1.DeletePostResponse
+	if err := p.client.do(ctx, &request{method: http.MethodDelete, path: "/v1/posts", body: rq}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (p *posts) Get(ctx context.Context, rq *v1.GetPostRequest) (*v1.GetPostResponse, error) {
+	var resp v1.GetPostResponse
+	if err := p.client.do(ctx, &request{method: http.MethodGet, path: postPath(rq.PostID)}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (p *posts) List(ctx context.Context, rq *v1.ListPostRequest) (*v1.ListPostResponse, error) {
+	var resp v1.ListPostResponse
+	if err := p.client.do(ctx, &request{method: http.MethodGet, path: "/v1/posts", query: encodeQuery(rq)}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (p *posts) Publish(ctx context.Context, rq *v1.PublishPostRequest) (*v1.PublishPostResponse, error) {
+	var resp v1.PublishPostResponse
+	if err := p.client.do(ctx, &request{method: http.MethodPost, path: postPath(rq.PostID, "publish"), body: rq}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (p *posts) Unpublish(ctx context.Context, rq *v1.UnpublishPostRequest) (*v1.UnpublishPostResponse, error) {
+	var resp v1.UnpublishPostResponse
+	if err := p.client.do(ctx, &request{method: http.MethodPost, path: postPath(rq.PostID, "unpublish"), body: rq}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (p *posts) ListPublic(ctx context.Context, rq *v1.ListPublicPostRequest) (*v1.ListPublicPostResponse, error) {
+	var resp v1.ListPublicPostResponse
+	if err := p.client.do(ctx, &request{method: http.MethodGet, path: "/v1/public/posts", query: encodeQuery(rq), public: true}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (p *posts) GetPublic(ctx context.Context, rq *v1.GetPublicPostRequest) (*v1.GetPublicPostResponse, error) {
+	var resp v1.GetPublicPostResponse
+	path := "/v1/public/posts/" + url.PathEscape(rq.PostID)
+	if err := p.client.do(ctx, &request{method: http.MethodGet, path: path, public: true}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (p *posts) ListRevisions(ctx context.Context, rq *v1.ListPostRevisionRequest) (*v1.ListPostRevisionResponse, error) {
+	var resp v1.ListPostRevisionResponse
+	if err := p.client.do(ctx, &request{method: http.MethodGet, path: postPath(rq.PostID, "revisions"), query: encodeQuery(rq)}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (p *posts) GetRevision(ctx context.Context, rq *v1.GetPostRevisionRequest) (*v1.GetPostRevisionResponse, error) {
+	var resp v1.GetPostRevisionResponse
+	path := postPath(rq.PostID, "revisions", strconv.FormatInt(rq.Revision, 10))
+	if err := p.client.do(ctx, &request{method: http.MethodGet, path: path}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (p *posts) DiffRevisions(ctx context.Context, rq *v1.DiffPostRevisionRequest) (*v1.DiffPostRevisionResponse, error) {
+	var resp v1.DiffPostRevisionResponse
+	if err := p.client.do(ctx, &request{method: http.MethodGet, path: postPath(rq.PostID, "revisions", "diff"), query: encodeQuery(rq)}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (p *posts) RestoreRevision(ctx context.Context, rq *v1.RestorePostRevisionRequest) (*v1.RestorePostRevisionResponse, error) {
+	var resp v1.RestorePostRevisionResponse
+	path := postPath(rq.PostID, "revisions", strconv.FormatInt(rq.Revision, 10), "restore")
+	if err := p.client.do(ctx, &request{method: http.MethodPost, path: path}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (p *posts) ListTrash(ctx context.Context, rq *v1.ListTrashPostRequest) (*v1.ListTrashPostResponse, error) {
+	var resp v1.ListTrashPostResponse
+	if err := p.client.do(ctx, &request{method: http.MethodGet, path: "/v1/posts/trash", query: encodeQuery(rq)}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (p *posts) Restore(ctx context.Context, rq *v1.RestorePostRequest) (*v1.RestorePostResponse, error) {
+	var resp v1.RestorePostResponse
+	if err := p.client.do(ctx, &request{method: http.MethodPost, path: postPath(rq.PostID, "restore")}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (p *posts) Search(ctx context.Context, rq *v1.SearchPostRequest) (*v1.SearchPostResponse, error) {
+	var resp v1.SearchPostResponse
+	if err := p.client.do(ctx, &request{method: http.MethodGet, path: "/v1/posts/search", query: encodeQuery(rq)}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
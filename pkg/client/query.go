@@ -0,0 +1,61 @@
+package client
+
+import (
+	"net/url"
+	"reflect"
+	"strconv"
+	"strings"
+)
+
+// encodeQuery 将请求结构体中带有 form 标签的字段编码为查询参数，零值字段会被忽略.
+// 匿名嵌入的结构体（例如 v1.PageRequest）会被展开.
+func encodeQuery(rq any) url.Values {
+	values := url.Values{}
+	v := reflect.Indirect(reflect.ValueOf(rq))
+	if v.Kind() == reflect.Struct {
+		addQuery(values, v)
+	}
+	return values
+}
+
+// addQuery 将结构体 v 中的字段添加到 values 中.
+func addQuery(values url.Values, v reflect.Value) {
+	t := v.Type()
+	for i := range t.NumField() {
+		sf, fv := t.Field(i), v.Field(i)
+		if sf.Anonymous && sf.Type.Kind() == reflect.Struct {
+			addQuery(values, fv)
+			continue
+		}
+
+		name, _, _ := strings.Cut(sf.Tag.Get("form"), ",")
+		if name == "" || name == "-" || !sf.IsExported() || fv.IsZero() {
+			continue
+		}
+
+		fv = reflect.Indirect(fv)
+		if fv.Kind() == reflect.Slice {
+			for j := range fv.Len() {
+				values.Add(name, formatValue(fv.Index(j)))
+			}
+			continue
+		}
+		values.Set(name, formatValue(fv))
+	}
+}
+
+// formatValue 将基础类型的值格式化为字符串.
+func formatValue(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'f', -1, 64)
+	default:
+		return v.String()
+	}
+}
@@ -0,0 +1,246 @@
+package client
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	"github.com/google/uuid"
+	"github.com/onexstack/onexstack/pkg/errorsx"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// headerRequestID 是传递请求 ID 的请求头和响应头.
+const headerRequestID = "X-Request-ID"
+
+// requestIDKey 定义请求 ID 的上下文键.
+type requestIDKey struct{}
+
+// WithRequestID 将请求 ID 存放到上下文中，使用该上下文发送的请求会通过 X-Request-ID 请求头携带这个 ID，
+// 便于在服务端日志中关联同一个调用链上的请求. 没有设置时每次调用都会生成新的请求 ID，重试时保持不变.
+func WithRequestID(ctx context.Context, requestID string) context.Context {
+	return context.WithValue(ctx, requestIDKey{}, requestID)
+}
+
+// RequestID 从上下文中提取请求 ID.
+func RequestID(ctx context.Context) string {
+	requestID, _ := ctx.Value(requestIDKey{}).(string)
+	return requestID
+}
+
+// request 描述一次接口调用.
+type request struct {
+	// method 是 HTTP 方法.
+	method string
+	// path 是请求路径，路径参数需要已经转义.
+	path string
+	// query 是查询参数.
+	query url.Values
+	// body 是请求体，为空表示没有请求体.
+	body any
+	// ifMatch 不为空时通过 If-Match 请求头携带资源的版本号.
+	ifMatch *int64
+	// public 表示接口无需认证.
+	public bool
+}
+
+// errorResponse 是服务端返回的错误响应.
+type errorResponse struct {
+	Reason  string `json:"reason"`
+	Message string `json:"message"`
+}
+
+// do 发送请求并将成功响应解析到 out 中，out 为空时忽略响应体.
+// 访问令牌失效时会刷新令牌后重试一次，可以重试的错误会按照指数退避重试.
+func (c *Client) do(ctx context.Context, rq *request, out any) error {
+	requestID := RequestID(ctx)
+	if requestID == "" {
+		requestID = uuid.New().String()
+	}
+
+	var body []byte
+	if rq.body != nil {
+		var err error
+		if body, err = json.Marshal(rq.body); err != nil {
+			return fmt.Errorf("failed to encode request body: %w", err)
+		}
+	}
+
+	var token string
+	if !rq.public {
+		var err error
+		if token, err = c.accessToken(ctx, ""); err != nil {
+			return err
+		}
+	}
+
+	reauthenticated := false
+	for attempt := 0; ; attempt++ {
+		resp, err := c.send(ctx, rq, body, token, requestID)
+		if err != nil {
+			// 请求发出后连接中断时，服务端可能已经处理了请求，只重试幂等的请求
+			if ctx.Err() == nil && attempt < c.maxRetries && idempotent(rq.method) {
+				if err := c.wait(ctx, attempt, 0); err != nil {
+					return err
+				}
+				continue
+			}
+			return fmt.Errorf("%s %s: %w", rq.method, rq.path, err)
+		}
+
+		// 访问令牌过期或被吊销时，刷新令牌后重试一次
+		if resp.StatusCode == http.StatusUnauthorized && !rq.public && !reauthenticated {
+			reauthenticated = true
+			if newToken, err := c.accessToken(ctx, token); err == nil && newToken != token {
+				drain(resp)
+				token = newToken
+				continue
+			}
+		}
+
+		if retryable(rq.method, resp.StatusCode) && attempt < c.maxRetries {
+			retryAfter := parseRetryAfter(resp.Header.Get("Retry-After"))
+			drain(resp)
+			if err := c.wait(ctx, attempt, retryAfter); err != nil {
+				return err
+			}
+			continue
+		}
+
+		return decodeResponse(resp, requestID, out)
+	}
+}
+
+// send 发送一次 HTTP 请求.
+func (c *Client) send(ctx context.Context, rq *request, body []byte, token string, requestID string) (*http.Response, error) {
+	u := c.baseURL + rq.path
+	if len(rq.query) > 0 {
+		u += "?" + rq.query.Encode()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		reader = bytes.NewReader(body)
+	}
+	req, err := http.NewRequestWithContext(ctx, rq.method, u, reader)
+	if err != nil {
+		return nil, err
+	}
+
+	req.Header.Set("Accept", "application/json")
+	req.Header.Set(headerRequestID, requestID)
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	if token != "" {
+		req.Header.Set("Authorization", "Bearer "+token)
+	}
+	if rq.ifMatch != nil {
+		req.Header.Set("If-Match", `"`+strconv.FormatInt(*rq.ifMatch, 10)+`"`)
+	}
+	// ctx 中有 OpenTelemetry span 时通过 W3C traceparent 传给服务端，服务端的 span 会加入调用方的链路
+	propagation.TraceContext{}.Inject(ctx, propagation.HeaderCarrier(req.Header))
+
+	return c.httpClient.Do(req)
+}
+
+// wait 在第 attempt 次重试前等待，retryAfter 为服务端要求的等待时间. 上下文取消时立即返回.
+func (c *Client) wait(ctx context.Context, attempt int, retryAfter time.Duration) error {
+	// 指数退避，并在 [backoff/2, backoff] 范围内随机，避免多个客户端同时重试
+	backoff := c.minBackoff << attempt
+	if backoff > c.maxBackoff || backoff <= 0 {
+		backoff = c.maxBackoff
+	}
+	backoff = backoff/2 + rand.N(backoff/2+1)
+	if retryAfter > backoff {
+		backoff = min(retryAfter, c.maxBackoff)
+	}
+
+	timer := time.NewTimer(backoff)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
+
+// idempotent 判断 HTTP 方法是否是幂等的.
+func idempotent(method string) bool {
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodPut, http.MethodDelete, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// retryable 判断响应是否可以重试. 429 表示请求被限流，服务端没有处理请求，所有方法都可以重试；
+// 502、503、504 时服务端可能已经处理了请求，只重试幂等的请求.
+func retryable(method string, code int) bool {
+	switch code {
+	case http.StatusTooManyRequests:
+		return true
+	case http.StatusBadGateway, http.StatusServiceUnavailable, http.StatusGatewayTimeout:
+		return idempotent(method)
+	}
+	return false
+}
+
+// parseRetryAfter 解析 Retry-After 响应头，只支持以秒为单位的格式.
+func parseRetryAfter(value string) time.Duration {
+	seconds, err := strconv.Atoi(value)
+	if err != nil || seconds < 0 {
+		return 0
+	}
+	return time.Duration(seconds) * time.Second
+}
+
+// drain 读取并关闭响应体，使连接可以复用.
+func drain(resp *http.Response) {
+	_, _ = io.Copy(io.Discard, resp.Body)
+	_ = resp.Body.Close()
+}
+
+// decodeResponse 解析响应. 错误响应会被还原为 *errorsx.ErrorX，并在元数据中记录请求 ID.
+func decodeResponse(resp *http.Response, requestID string, out any) error {
+	defer drain(resp)
+
+	data, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return fmt.Errorf("failed to read response body: %w", err)
+	}
+
+	if resp.StatusCode >= http.StatusBadRequest {
+		var errResp errorResponse
+		if err := json.Unmarshal(data, &errResp); err != nil || errResp.Reason == "" {
+			// 不是 fg-apiserver 返回的错误，例如网关返回的错误页面
+			errResp = errorResponse{Reason: http.StatusText(resp.StatusCode), Message: string(data)}
+		}
+		return errorsx.New(resp.StatusCode, errResp.Reason, "%s", errResp.Message).WithRequestID(requestID)
+	}
+
+	if out == nil || len(data) == 0 {
+		return nil
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return fmt.Errorf("failed to decode response body: %w", err)
+	}
+	return nil
+}
+
+// IsReason 判断 err 是否是服务端返回的指定原因的错误，reason 例如 NotFound.PostNotFound.
+func IsReason(err error, reason string) bool {
+	if errx := new(errorsx.ErrorX); errors.As(err, &errx) {
+		return errx.Reason == reason
+	}
+	return false
+}
@@ -0,0 +1,104 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"sync"
+	"time"
+
+	v1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+)
+
+// expirySkew 是提前刷新访问令牌的时间，避免令牌在请求途中过期.
+const expirySkew = 30 * time.Second
+
+// Token 表示登录后获得的访问令牌和刷新令牌.
+type Token struct {
+	// Token 是访问令牌.
+	Token string `json:"token"`
+	// ExpireAt 是访问令牌的过期时间，为零值表示未知.
+	ExpireAt time.Time `json:"expireAt"`
+	// RefreshToken 是刷新令牌.
+	RefreshToken string `json:"refreshToken"`
+	// RefreshExpireAt 是刷新令牌的过期时间，为零值表示未知.
+	RefreshExpireAt time.Time `json:"refreshExpireAt"`
+}
+
+// session 保存登录凭证和令牌，负责获取和刷新访问令牌.
+type session struct {
+	mu       sync.Mutex
+	token    Token
+	username string
+	password string
+	listener func(Token)
+}
+
+// current 返回当前的令牌.
+func (s *session) current() Token {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.token
+}
+
+// update 保存登录或刷新令牌后获得的新令牌.
+func (s *session) update(token Token) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.set(token)
+}
+
+// set 保存新的令牌并通知 listener，调用方需要持有锁.
+func (s *session) set(token Token) {
+	s.token = token
+	if s.listener != nil {
+		s.listener(token)
+	}
+}
+
+// expired 判断令牌是否已经过期或即将过期，过期时间未知时认为没有过期.
+func expired(expireAt time.Time) bool {
+	return !expireAt.IsZero() && time.Now().Add(expirySkew).After(expireAt)
+}
+
+// accessToken 返回可用的访问令牌. 访问令牌即将过期或者与被服务端拒绝的令牌 rejected 相同时，
+// 先尝试使用刷新令牌续期，失败后使用用户名和密码重新登录. 并发的请求同时被拒绝时只会续期一次.
+func (c *Client) accessToken(ctx context.Context, rejected string) (string, error) {
+	s := c.session
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if s.token.Token != "" && s.token.Token != rejected && !expired(s.token.ExpireAt) {
+		return s.token.Token, nil
+	}
+
+	if s.token.RefreshToken != "" && !expired(s.token.RefreshExpireAt) {
+		var resp v1.RefreshTokenResponse
+		err := c.do(ctx, &request{method: http.MethodPost, path: "/refresh-token", body: &v1.RefreshTokenRequest{RefreshToken: s.token.RefreshToken}, public: true}, &resp)
+		if err == nil {
+			s.set(Token{Token: resp.Token, ExpireAt: resp.ExpireAt, RefreshToken: resp.RefreshToken, RefreshExpireAt: resp.RefreshExpireAt})
+			return s.token.Token, nil
+		}
+		// 没有用户名和密码时无法重新登录，直接返回刷新失败的原因
+		if s.username == "" {
+			return "", err
+		}
+	}
+
+	if s.username != "" {
+		var resp v1.LoginResponse
+		err := c.do(ctx, &request{method: http.MethodPost, path: "/login", body: &v1.LoginRequest{Username: s.username, Password: s.password}, public: true}, &resp)
+		if err != nil {
+			return "", err
+		}
+		s.set(Token{Token: resp.Token, ExpireAt: resp.ExpireAt, RefreshToken: resp.RefreshToken, RefreshExpireAt: resp.RefreshExpireAt})
+		return s.token.Token, nil
+	}
+
+	// 既不能续期也不能登录时，仍然使用原有的访问令牌，由服务端判断是否有效
+	return s.token.Token, nil
+}
+
+// clear 清空保存的令牌，退出登录后调用. 用户名和密码会被保留，之后的请求会重新登录.
+func (s *session) clear() {
+	s.update(Token{})
+}
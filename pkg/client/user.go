@@ -0,0 +1,185 @@
+package client
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+
+	v1 "github.com/onexstack/fastgo/pkg/api/apiserver/v1"
+)
+
+// UserClient 定义了用户相关的接口，方法与服务端的 UserBiz 一一对应.
+type UserClient interface {
+	Create(ctx context.Context, rq *v1.CreateUserRequest) (*v1.CreateUserResponse, error)
+	// Update 更新用户信息，rq.Version 不为空时通过 If-Match 请求头携带版本号，版本号不一致会拒绝更新.
+	Update(ctx context.Context, rq *v1.UpdateUserRequest) (*v1.UpdateUserResponse, error)
+	Delete(ctx context.Context, rq *v1.DeleteUserRequest) (*v1.DeleteUserResponse, error)
+	Get(ctx context.Context, rq *v1.GetUserRequest) (*v1.GetUserResponse, error)
+	List(ctx context.Context, rq *v1.ListUserRequest) (*v1.ListUserResponse, error)
+
+	// Login 登录，登录成功后客户端会使用返回的令牌发送后续请求.
+	Login(ctx context.Context, rq *v1.LoginRequest) (*v1.LoginResponse, error)
+	// RefreshToken 使用刷新令牌换取新的令牌，成功后客户端会使用新的令牌发送后续请求.
+	RefreshToken(ctx context.Context, rq *v1.RefreshTokenRequest) (*v1.RefreshTokenResponse, error)
+	// Logout 退出当前会话，成功后客户端会清空保存的令牌.
+	Logout(ctx context.Context, rq *v1.LogoutRequest) (*v1.LogoutResponse, error)
+	// LogoutAll 退出当前用户的所有会话，成功后客户端会清空保存的令牌.
+	LogoutAll(ctx context.Context, rq *v1.LogoutAllRequest) (*v1.LogoutAllResponse, error)
+	// ChangePassword 修改当前用户的密码.
+	ChangePassword(ctx context.Context, rq *v1.ChangePasswordRequest) (*v1.ChangePasswordResponse, error)
+	// UpdateRole 修改用户角色，默认仅管理员可以调用.
+	UpdateRole(ctx context.Context, rq *v1.UpdateUserRoleRequest) (*v1.UpdateUserRoleResponse, error)
+	// ListTrash 返回回收站中的用户，仅管理员可以调用.
+	ListTrash(ctx context.Context, rq *v1.ListTrashUserRequest) (*v1.ListTrashUserResponse, error)
+	// Restore 将用户从回收站中恢复，仅管理员可以调用.
+	Restore(ctx context.Context, rq *v1.RestoreUserRequest) (*v1.RestoreUserResponse, error)
+	// CancelDeletion 在宽限期内取消删除账号.
+	CancelDeletion(ctx context.Context, rq *v1.CancelUserDeletionRequest) (*v1.CancelUserDeletionResponse, error)
+	// Export 以 JSON 格式导出用户的所有个人数据，rq.Format 会被忽略.
+	Export(ctx context.Context, rq *v1.ExportUserRequest) (*v1.ExportUserResponse, error)
+}
+
+// users 实现了 UserClient 接口.
+type users struct {
+	client *Client
+}
+
+var _ UserClient = (*users)(nil)
+
+// userPath 返回指定用户的接口路径.
+func userPath(userID string, subpaths ...string) string {
+	path := "/v1/users/" + url.PathEscape(userID)
+	for _, subpath := range subpaths {
+		path += "/" + subpath
+	}
+	return path
+}
+
+func (u *users) Create(ctx context.Context, rq *v1.CreateUserRequest) (*v1.CreateUserResponse, error) {
+	var resp v1.CreateUserResponse
+	if err := u.client.do(ctx, &request{method: http.MethodPost, path: "/v1/users", body: rq, public: true}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (u *users) Update(ctx context.Context, rq *v1.UpdateUserRequest) (*v1.UpdateUserResponse, error) {
+	var resp v1.UpdateUserResponse
+	if err := u.client.do(ctx, &request{method: http.MethodPut, path: userPath(rq.UserID), body: rq, ifMatch: rq.Version}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (u *users) Delete(ctx context.Context, rq *v1.DeleteUserRequest) (*v1.DeleteUserResponse, error) {
+	var resp v1.DeleteUserResponse
+	if err := u.client.do(ctx, &request{method: http.MethodDelete, path: userPath(rq.UserID)}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (u *users) Get(ctx context.Context, rq *v1.GetUserRequest) (*v1.GetUserResponse, error) {
+	var resp v1.GetUserResponse
+	if err := u.client.do(ctx, &request{method: http.MethodGet, path: userPath(rq.UserID)}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (u *users) List(ctx context.Context, rq *v1.ListUserRequest) (*v1.ListUserResponse, error) {
+	var resp v1.ListUserResponse
+	if err := u.client.do(ctx, &request{method: http.MethodGet, path: "/v1/users", query: encodeQuery(rq)}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (u *users) Login(ctx context.Context, rq *v1.LoginRequest) (*v1.LoginResponse, error) {
+	var resp v1.LoginResponse
+	if err := u.client.do(ctx, &request{method: http.MethodPost, path: "/login", body: rq, public: true}, &resp); err != nil {
+		return nil, err
+	}
+
+	u.client.session.update(Token{Token: resp.Token, ExpireAt: resp.ExpireAt, RefreshToken: resp.RefreshToken, RefreshExpireAt: resp.RefreshExpireAt})
+	return &resp, nil
+}
+
+func (u *users) RefreshToken(ctx context.Context, rq *v1.RefreshTokenRequest) (*v1.RefreshTokenResponse, error) {
+	var resp v1.RefreshTokenResponse
+	if err := u.client.do(ctx, &request{method: http.MethodPost, path: "/refresh-token", body: rq, public: true}, &resp); err != nil {
+		return nil, err
+	}
+
+	u.client.session.update(Token{Token: resp.Token, ExpireAt: resp.ExpireAt, RefreshToken: resp.RefreshToken, RefreshExpireAt: resp.RefreshExpireAt})
+	return &resp, nil
+}
+
+func (u *users) Logout(ctx context.Context, rq *v1.LogoutRequest) (*v1.LogoutResponse, error) {
+	var resp v1.LogoutResponse
+	if err := u.client.do(ctx, &request{method: http.MethodPost, path: "/logout"}, &resp); err != nil {
+		return nil, err
+	}
+
+	u.client.session.clear()
+	return &resp, nil
+}
+
+func (u *users) LogoutAll(ctx context.Context, rq *v1.LogoutAllRequest) (*v1.LogoutAllResponse, error) {
+	var resp v1.LogoutAllResponse
+	if err := u.client.do(ctx, &request{method: http.MethodPost, path: "/logout-all"}, &resp); err != nil {
+		return nil, err
+	}
+
+	u.client.session.clear()
+	return &resp, nil
+}
+
+func (u *users) ChangePassword(ctx context.Context, rq *v1.ChangePasswordRequest) (*v1.ChangePasswordResponse, error) {
+	var resp v1.ChangePasswordResponse
+	if err := u.client.do(ctx, &request{method: http.MethodPut, path: userPath(rq.UserID, "change-password"), body: rq}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (u *users) UpdateRole(ctx context.Context, rq *v1.UpdateUserRoleRequest) (*v1.UpdateUserRoleResponse, error) {
+	var resp v1.UpdateUserRoleResponse
+	if err := u.client.do(ctx, &request{method: http.MethodPut, path: userPath(rq.UserID, "role"), body: rq}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (u *users) ListTrash(ctx context.Context, rq *v1.ListTrashUserRequest) (*v1.ListTrashUserResponse, error) {
+	var resp v1.ListTrashUserResponse
+	if err := u.client.do(ctx, &request{method: http.MethodGet, path: "/v1/users/trash", query: encodeQuery(rq)}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (u *users) Restore(ctx context.Context, rq *v1.RestoreUserRequest) (*v1.RestoreUserResponse, error) {
+	var resp v1.RestoreUserResponse
+	if err := u.client.do(ctx, &request{method: http.MethodPost, path: userPath(rq.UserID, "restore")}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (u *users) CancelDeletion(ctx context.Context, rq *v1.CancelUserDeletionRequest) (*v1.CancelUserDeletionResponse, error) {
+	var resp v1.CancelUserDeletionResponse
+	if err := u.client.do(ctx, &request{method: http.MethodPost, path: userPath(rq.UserID, "cancel-deletion")}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
+func (u *users) Export(ctx context.Context, rq *v1.ExportUserRequest) (*v1.ExportUserResponse, error) {
+	var resp v1.ExportUserResponse
+	query := url.Values{"format": {"json"}}
+	if err := u.client.do(ctx, &request{method: http.MethodGet, path: userPath(rq.UserID, "export"), query: query}, &resp); err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
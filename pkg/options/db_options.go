@@ -0,0 +1,56 @@
+package options
+
+import (
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"time"
+
+	"gorm.io/gorm/logger"
+)
+
+const (
+	// DriverMySQL 表示使用 MySQL 作为存储后端.
+	DriverMySQL = "mysql"
+	// DriverSQLite 表示使用嵌入式 SQLite 文件作为存储后端.
+	DriverSQLite = "sqlite"
+	// DriverMemory 表示使用 SQLite 内存数据库作为存储后端，进程退出后数据丢失.
+	DriverMemory = "memory"
+)
+
+// DBOptions 定义了存储后端的选择配置.
+type DBOptions struct {
+	// Driver 指定存储后端类型，可选值为 mysql、sqlite、memory.
+	Driver string `json:"driver" mapstructure:"driver"`
+	// AutoMigrate 为 true 时，服务启动时会自动执行未执行的数据库迁移.
+	// 为 false 时，如果存在未执行的迁移，服务会拒绝启动.
+	AutoMigrate bool `json:"auto-migrate" mapstructure:"auto-migrate"`
+}
+
+// NewDBOptions 创建一个带有默认值的 DBOptions 实例.
+func NewDBOptions() *DBOptions {
+	return &DBOptions{
+		Driver: DriverMySQL,
+	}
+}
+
+// Validate 校验 DBOptions 中的配置是否合法.
+func (o *DBOptions) Validate() error {
+	drivers := []string{DriverMySQL, DriverSQLite, DriverMemory}
+	if !slices.Contains(drivers, o.Driver) {
+		return fmt.Errorf("invalid db driver '%s', must be one of %v", o.Driver, drivers)
+	}
+
+	return nil
+}
+
+// newGormLogger 创建 GORM 使用的日志记录器. 日志中的 SQL 只包含占位符，不包含参数，避免输出密码哈希、令牌等敏感数据.
+func newGormLogger() logger.Interface {
+	return logger.New(log.New(os.Stdout, "\r\n", log.LstdFlags), logger.Config{
+		SlowThreshold:        200 * time.Millisecond,
+		LogLevel:             logger.Warn,
+		Colorful:             true,
+		ParameterizedQueries: true,
+	})
+}
@@ -0,0 +1,39 @@
+package options
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+)
+
+// GRPCOptions 定义了 gRPC 服务相关的配置.
+type GRPCOptions struct {
+	// Addr 是 gRPC 服务的监听地址，不能与 REST API 的监听地址相同.
+	Addr string `json:"addr" mapstructure:"addr"`
+}
+
+// NewGRPCOptions 创建一个带有默认值的 GRPCOptions 实例.
+func NewGRPCOptions() *GRPCOptions {
+	return &GRPCOptions{
+		Addr: "0.0.0.0:6667",
+	}
+}
+
+// Validate 校验 GRPCOptions 中的配置是否合法.
+func (o *GRPCOptions) Validate() error {
+	if o.Addr == "" {
+		return fmt.Errorf("grpc.addr cannot be empty")
+	}
+
+	_, portStr, err := net.SplitHostPort(o.Addr)
+	if err != nil {
+		return fmt.Errorf("invalid grpc.addr: %s", o.Addr)
+	}
+
+	port, err := strconv.Atoi(portStr)
+	if err != nil || port <= 0 || port > 65535 {
+		return fmt.Errorf("invalid grpc.addr port: %s", portStr)
+	}
+
+	return nil
+}
@@ -0,0 +1,106 @@
+package options
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/onexstack/fastgo/pkg/token"
+)
+
+const (
+	// MinJWTKeyLength 是 HMAC 签名密钥的最小长度，HS256 要求密钥至少为 256 位.
+	MinJWTKeyLength = 32
+	// MinJWTExpiration 和 MaxJWTExpiration 定义了访问令牌有效期的合法范围.
+	// 访问令牌过期后可以使用刷新令牌续期，因此不需要很长的有效期.
+	MinJWTExpiration = time.Minute
+	MaxJWTExpiration = 24 * time.Hour
+)
+
+// JWTOptions 定义了 JWT 签发和校验相关的配置.
+type JWTOptions struct {
+	// Key 是 HMAC（HS256）签名密钥，为空时使用 token 包内置的默认密钥.
+	Key string `json:"-" mapstructure:"key"`
+	// Expiration 是访问令牌的有效期.
+	Expiration time.Duration `json:"expiration" mapstructure:"expiration"`
+	// Issuer 是 token 的签发者（iss），解析 token 时会校验该值.
+	Issuer string `json:"issuer" mapstructure:"issuer"`
+	// Audience 是 token 的受众（aud），不为空时解析 token 会校验该值.
+	Audience string `json:"audience" mapstructure:"audience"`
+	// SigningKeyFile 是签发 token 使用的 PEM 格式私钥文件，支持 RSA（RS256）和 Ed25519（EdDSA）.
+	// 为空时使用 HMAC（HS256）密钥签发.
+	SigningKeyFile string `json:"signing-key-file" mapstructure:"signing-key-file"`
+	// VerificationKeyFiles 是额外的 PEM 格式校验密钥文件（公钥或私钥）.
+	// 轮换密钥时，将旧的签发密钥放到这里，旧密钥签发的 token 在过期前仍然有效.
+	VerificationKeyFiles []string `json:"verification-key-files" mapstructure:"verification-key-files"`
+}
+
+// NewJWTOptions 创建一个带有默认值的 JWTOptions 实例.
+func NewJWTOptions() *JWTOptions {
+	return &JWTOptions{
+		Expiration: 2 * time.Hour,
+		Issuer:     "fg-apiserver",
+	}
+}
+
+// Validate 校验 JWTOptions 中的配置是否合法.
+func (o *JWTOptions) Validate() error {
+	if o.Key != "" && len(o.Key) < MinJWTKeyLength {
+		return fmt.Errorf("jwt.key must be at least %d characters long", MinJWTKeyLength)
+	}
+
+	if o.Expiration < MinJWTExpiration || o.Expiration > MaxJWTExpiration {
+		return fmt.Errorf("jwt.expiration must be between %s and %s, got %s", MinJWTExpiration, MaxJWTExpiration, o.Expiration)
+	}
+
+	if o.Issuer == "" {
+		return fmt.Errorf("jwt.issuer cannot be empty")
+	}
+
+	if o.SigningKeyFile == "" && len(o.VerificationKeyFiles) > 0 {
+		return fmt.Errorf("jwt.verification-key-files requires jwt.signing-key-file to be set")
+	}
+
+	for _, file := range append([]string{o.SigningKeyFile}, o.VerificationKeyFiles...) {
+		if file == "" {
+			continue
+		}
+		if _, err := os.Stat(file); err != nil {
+			return fmt.Errorf("invalid jwt key file: %w", err)
+		}
+	}
+
+	return nil
+}
+
+// UsesDefaultKey 判断是否会使用内置的默认密钥签发 token.
+// 内置密钥随源码公开，任何人都可以用它伪造 token.
+func (o *JWTOptions) UsesDefaultKey() bool {
+	return o.SigningKeyFile == "" && (o.Key == "" || o.Key == token.DefaultKey)
+}
+
+// Init 使用 JWTOptions 初始化 token 包，并加载配置的签发密钥和校验密钥.
+func (o *JWTOptions) Init() error {
+	token.Init(o.Key, "", o.Expiration, o.Issuer, o.Audience)
+
+	// 未配置签发密钥时保持使用 HMAC 密钥
+	if o.SigningKeyFile == "" {
+		return nil
+	}
+
+	signing, err := token.LoadKeyFile(o.SigningKeyFile)
+	if err != nil {
+		return err
+	}
+
+	verification := make([]*token.Key, 0, len(o.VerificationKeyFiles))
+	for _, file := range o.VerificationKeyFiles {
+		key, err := token.LoadKeyFile(file)
+		if err != nil {
+			return err
+		}
+		verification = append(verification, key)
+	}
+
+	return token.SetKeys(signing, verification...)
+}
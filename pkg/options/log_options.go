@@ -0,0 +1,164 @@
+package options
+
+import (
+	"fmt"
+	"log/slog"
+	"slices"
+)
+
+// 支持的日志输出.
+const (
+	// LogOutputConsole 输出到标准输出或标准错误.
+	LogOutputConsole = "console"
+	// LogOutputFile 输出到文件，文件按照大小自动轮转.
+	LogOutputFile = "file"
+	// LogOutputSyslog 输出到本机或远程的 syslog 服务.
+	LogOutputSyslog = "syslog"
+)
+
+// 支持的日志格式.
+const (
+	LogFormatText = "text"
+	LogFormatJSON = "json"
+)
+
+// LogOptions 定义了日志相关的配置. 可以同时启用多个日志输出，每条日志会写入所有输出.
+type LogOptions struct {
+	// Format 是日志格式，可选值为 text、json.
+	Format string `json:"format" mapstructure:"format"`
+	// Level 是默认的日志级别，可选值为 debug、info、warn、error. 运行时可以通过管理接口或 SIGHUP 信号修改.
+	Level string `json:"level" mapstructure:"level"`
+	// Levels 按包覆盖默认的日志级别，键为包路径，同时作用于子包，本项目的包可以省略模块路径.
+	Levels map[string]string `json:"levels" mapstructure:"levels"`
+	// Outputs 是启用的日志输出，可选值为 console、file、syslog.
+	Outputs []string `json:"outputs" mapstructure:"outputs"`
+	// Console 是 console 输出的配置.
+	Console LogConsoleOptions `json:"console" mapstructure:"console"`
+	// File 是 file 输出的配置.
+	File LogFileOptions `json:"file" mapstructure:"file"`
+	// Syslog 是 syslog 输出的配置.
+	Syslog LogSyslogOptions `json:"syslog" mapstructure:"syslog"`
+}
+
+// LogConsoleOptions 定义了 console 日志输出的配置.
+type LogConsoleOptions struct {
+	// Stream 是输出流，可选值为 stdout、stderr.
+	Stream string `json:"stream" mapstructure:"stream"`
+}
+
+// LogFileOptions 定义了 file 日志输出的配置.
+type LogFileOptions struct {
+	// Path 是日志文件路径，轮转后的旧文件保存在同一目录下.
+	Path string `json:"path" mapstructure:"path"`
+	// MaxSize 是单个日志文件的最大大小，单位为 MB，超过后轮转.
+	MaxSize int `json:"max-size" mapstructure:"max-size"`
+	// MaxAge 是旧日志文件的最长保留天数，0 表示不按时间清理.
+	MaxAge int `json:"max-age" mapstructure:"max-age"`
+	// MaxBackups 是最多保留的旧日志文件数量，0 表示不按数量清理.
+	MaxBackups int `json:"max-backups" mapstructure:"max-backups"`
+	// Compress 表示是否使用 gzip 压缩轮转后的旧日志文件.
+	Compress bool `json:"compress" mapstructure:"compress"`
+}
+
+// LogSyslogOptions 定义了 syslog 日志输出的配置.
+type LogSyslogOptions struct {
+	// Network 是连接 syslog 服务使用的网络，可选值为 udp、tcp，为空时连接本机的 syslog 服务.
+	Network string `json:"network" mapstructure:"network"`
+	// Addr 是远程 syslog 服务的地址，Network 为空时忽略.
+	Addr string `json:"addr" mapstructure:"addr"`
+	// Tag 是日志的标签，通常为程序名称.
+	Tag string `json:"tag" mapstructure:"tag"`
+}
+
+// NewLogOptions 创建一个带有默认值的 LogOptions 实例.
+func NewLogOptions() *LogOptions {
+	return &LogOptions{
+		Format:  LogFormatText,
+		Level:   "info",
+		Outputs: []string{LogOutputConsole},
+		Console: LogConsoleOptions{
+			Stream: "stdout",
+		},
+		File: LogFileOptions{
+			Path:       "fg-apiserver.log",
+			MaxSize:    100,
+			MaxAge:     30,
+			MaxBackups: 10,
+			Compress:   true,
+		},
+		Syslog: LogSyslogOptions{
+			Tag: "fg-apiserver",
+		},
+	}
+}
+
+// Validate 校验 LogOptions 中的配置是否合法.
+func (o *LogOptions) Validate() error {
+	formats := []string{LogFormatText, LogFormatJSON}
+	if !slices.Contains(formats, o.Format) {
+		return fmt.Errorf("invalid log.format '%s', must be one of %v", o.Format, formats)
+	}
+
+	if err := validateLogLevel(o.Level); err != nil {
+		return fmt.Errorf("invalid log.level: %w", err)
+	}
+	for pkg, level := range o.Levels {
+		if err := validateLogLevel(level); err != nil {
+			return fmt.Errorf("invalid log.levels of package '%s': %w", pkg, err)
+		}
+	}
+
+	if len(o.Outputs) == 0 {
+		return fmt.Errorf("log.outputs cannot be empty")
+	}
+
+	outputs := []string{LogOutputConsole, LogOutputFile, LogOutputSyslog}
+	for i, output := range o.Outputs {
+		if !slices.Contains(outputs, output) {
+			return fmt.Errorf("invalid log.outputs '%s', must be one of %v", output, outputs)
+		}
+		if slices.Contains(o.Outputs[:i], output) {
+			return fmt.Errorf("duplicate log.outputs '%s'", output)
+		}
+	}
+
+	if slices.Contains(o.Outputs, LogOutputConsole) {
+		streams := []string{"stdout", "stderr"}
+		if !slices.Contains(streams, o.Console.Stream) {
+			return fmt.Errorf("invalid log.console.stream '%s', must be one of %v", o.Console.Stream, streams)
+		}
+	}
+
+	if slices.Contains(o.Outputs, LogOutputFile) {
+		if o.File.Path == "" {
+			return fmt.Errorf("log.file.path cannot be empty")
+		}
+		if o.File.MaxSize <= 0 {
+			return fmt.Errorf("log.file.max-size must be greater than 0")
+		}
+		if o.File.MaxAge < 0 || o.File.MaxBackups < 0 {
+			return fmt.Errorf("log.file.max-age and log.file.max-backups cannot be negative")
+		}
+	}
+
+	if slices.Contains(o.Outputs, LogOutputSyslog) {
+		networks := []string{"", "udp", "tcp"}
+		if !slices.Contains(networks, o.Syslog.Network) {
+			return fmt.Errorf("invalid log.syslog.network '%s', must be one of udp, tcp or empty for the local syslog", o.Syslog.Network)
+		}
+		if o.Syslog.Network != "" && o.Syslog.Addr == "" {
+			return fmt.Errorf("log.syslog.addr cannot be empty when log.syslog.network is '%s'", o.Syslog.Network)
+		}
+	}
+
+	return nil
+}
+
+// validateLogLevel 校验日志级别是否合法，支持 slog 的 "info+2" 格式.
+func validateLogLevel(level string) error {
+	var l slog.Level
+	if err := l.UnmarshalText([]byte(level)); err != nil {
+		return fmt.Errorf("unknown log level '%s'", level)
+	}
+	return nil
+}
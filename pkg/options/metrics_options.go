@@ -0,0 +1,42 @@
+package options
+
+import (
+	"fmt"
+	"net"
+	"strconv"
+)
+
+// MetricsOptions 定义了 Prometheus 指标相关的配置.
+type MetricsOptions struct {
+	// Enabled 表示是否暴露 /metrics 接口.
+	Enabled bool `json:"enabled" mapstructure:"enabled"`
+	// Addr 是 /metrics 接口单独的监听地址，为空时与 REST API 共用同一个监听地址.
+	Addr string `json:"addr" mapstructure:"addr"`
+}
+
+// NewMetricsOptions 创建一个带有默认值的 MetricsOptions 实例.
+func NewMetricsOptions() *MetricsOptions {
+	return &MetricsOptions{
+		Enabled: true,
+		Addr:    "",
+	}
+}
+
+// Validate 校验 MetricsOptions 中的配置是否合法.
+func (o *MetricsOptions) Validate() error {
+	if o.Addr == "" {
+		return nil
+	}
+
+	_, portStr, err := net.SplitHostPort(o.Addr)
+	if err != nil {
+		return fmt.Errorf("invalid metrics.addr: %s", o.Addr)
+	}
+
+	port, err := strconv.Atoi(portStr)
+	if err != nil || port <= 0 || port > 65535 {
+		return fmt.Errorf("invalid metrics.addr port: %s", portStr)
+	}
+
+	return nil
+}
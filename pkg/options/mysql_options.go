@@ -23,6 +23,7 @@ type MySQLOptions struct {
 func (o *MySQLOptions) NewDB() (*gorm.DB, error) {
 	db, err := gorm.Open(mysql.Open(o.DSN()), &gorm.Config{
 		PrepareStmt: true,
+		Logger:      newGormLogger(),
 	})
 
 	if err != nil {
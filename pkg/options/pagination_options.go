@@ -0,0 +1,29 @@
+package options
+
+import (
+	"fmt"
+)
+
+// MinPageTokenKeyLength 是分页令牌签名密钥的最小长度.
+const MinPageTokenKeyLength = 32
+
+// PaginationOptions 定义了列表接口分页相关的配置.
+type PaginationOptions struct {
+	// TokenKey 是分页令牌的 HMAC 签名密钥，为空时在启动时随机生成.
+	// 随机密钥只在当前实例内有效，部署多个实例时需要配置相同的密钥，否则翻页请求落到其它实例时分页令牌会失效.
+	TokenKey string `json:"-" mapstructure:"token-key"`
+}
+
+// NewPaginationOptions 创建一个带有默认值的 PaginationOptions 实例.
+func NewPaginationOptions() *PaginationOptions {
+	return &PaginationOptions{}
+}
+
+// Validate 校验 PaginationOptions 中的配置是否合法.
+func (o *PaginationOptions) Validate() error {
+	if o.TokenKey != "" && len(o.TokenKey) < MinPageTokenKeyLength {
+		return fmt.Errorf("pagination.token-key must be at least %d characters long", MinPageTokenKeyLength)
+	}
+
+	return nil
+}
@@ -0,0 +1,27 @@
+package options
+
+import (
+	"fmt"
+)
+
+// PostOptions 定义了博客相关的配置.
+type PostOptions struct {
+	// MaxRevisions 是每篇博客最多保留的修订版本数量，超出时删除最早的版本. 0 表示不限制.
+	MaxRevisions int `json:"max-revisions" mapstructure:"max-revisions"`
+}
+
+// NewPostOptions 创建一个带有默认值的 PostOptions 实例.
+func NewPostOptions() *PostOptions {
+	return &PostOptions{
+		MaxRevisions: 50,
+	}
+}
+
+// Validate 校验 PostOptions 中的配置是否合法.
+func (o *PostOptions) Validate() error {
+	if o.MaxRevisions < 0 {
+		return fmt.Errorf("post.max-revisions cannot be negative, got %d", o.MaxRevisions)
+	}
+
+	return nil
+}
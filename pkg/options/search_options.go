@@ -0,0 +1,39 @@
+package options
+
+import (
+	"fmt"
+	"slices"
+)
+
+const (
+	// SearchDriverBleve 表示使用内嵌的 Bleve 倒排索引实现全文检索，适合本地开发和测试.
+	SearchDriverBleve = "bleve"
+	// SearchDriverMySQL 表示使用 MySQL 的 FULLTEXT 索引实现全文检索，需要使用 MySQL 作为存储后端.
+	SearchDriverMySQL = "mysql"
+)
+
+// SearchOptions 定义了博客全文检索相关的配置.
+type SearchOptions struct {
+	// Driver 指定全文检索的实现，可选值为 bleve、mysql.
+	Driver string `json:"driver" mapstructure:"driver"`
+	// IndexPath 是 Bleve 索引的存储目录，为空时使用内存索引.
+	// 内存索引和新建的索引会在启动时根据数据库中的博客重建.
+	IndexPath string `json:"index-path" mapstructure:"index-path"`
+}
+
+// NewSearchOptions 创建一个带有默认值的 SearchOptions 实例.
+func NewSearchOptions() *SearchOptions {
+	return &SearchOptions{
+		Driver: SearchDriverBleve,
+	}
+}
+
+// Validate 校验 SearchOptions 中的配置是否合法.
+func (o *SearchOptions) Validate() error {
+	drivers := []string{SearchDriverBleve, SearchDriverMySQL}
+	if !slices.Contains(drivers, o.Driver) {
+		return fmt.Errorf("invalid search.driver '%s', must be one of %v", o.Driver, drivers)
+	}
+
+	return nil
+}
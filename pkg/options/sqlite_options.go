@@ -0,0 +1,113 @@
+package options
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/glebarez/sqlite"
+	"gorm.io/gorm"
+)
+
+// InMemoryDatabase 是 SQLite 内存数据库的特殊文件名.
+const InMemoryDatabase = ":memory:"
+
+type SQLiteOptions struct {
+	// Database 指定 SQLite 数据库文件路径，设置为 ":memory:" 时使用内存数据库.
+	Database              string        `json:"database" mapstructure:"database"`
+	MaxIdleConnections    int           `json:"max-idle-connections,omitempty" mapstructure:"max-idle-connections"`
+	MaxOpenConnections    int           `json:"max-open-connections,omitempty" mapstructure:"max-open-connections"`
+	MaxConnectionLifeTime time.Duration `json:"max-connection-life-time,omitempty" mapstructure:"max-connection-life-time"`
+}
+
+func (o *SQLiteOptions) NewDB() (*gorm.DB, error) {
+	db, err := gorm.Open(sqlite.Open(o.DSN()), &gorm.Config{
+		PrepareStmt: true,
+		Logger:      newGormLogger(),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+
+	// 内存数据库的生命周期与连接绑定，连接关闭后数据即丢失，
+	// 所以只保留一个永不过期的连接，保证所有请求访问的是同一个数据库.
+	if o.InMemory() {
+		sqlDB.SetMaxIdleConns(1)
+		sqlDB.SetMaxOpenConns(1)
+		sqlDB.SetConnMaxLifetime(0)
+
+		return db, nil
+	}
+
+	sqlDB.SetMaxIdleConns(o.MaxIdleConnections)
+	sqlDB.SetMaxOpenConns(o.MaxOpenConnections)
+	sqlDB.SetConnMaxLifetime(o.MaxConnectionLifeTime)
+
+	return db, nil
+}
+
+// InMemory 判断是否使用 SQLite 内存数据库.
+func (o *SQLiteOptions) InMemory() bool {
+	return o.Database == InMemoryDatabase
+}
+
+// DSN return DSN from SQLiteOptions.
+func (o *SQLiteOptions) DSN() string {
+	if o.InMemory() {
+		return InMemoryDatabase
+	}
+
+	// 开启 WAL 并设置忙等待时间，避免并发写入时立即返回 "database is locked" 错误
+	return fmt.Sprintf("%s?_pragma=busy_timeout(5000)&_pragma=journal_mode(WAL)", o.Database)
+}
+
+func NewSQLiteOptions() *SQLiteOptions {
+	return &SQLiteOptions{
+		Database:              "fastgo.db",
+		MaxIdleConnections:    10,
+		MaxOpenConnections:    10,
+		MaxConnectionLifeTime: time.Duration(10) * time.Second,
+	}
+}
+
+// NewMemoryOptions 创建使用 SQLite 内存数据库的 SQLiteOptions 实例.
+func NewMemoryOptions() *SQLiteOptions {
+	opts := NewSQLiteOptions()
+	opts.Database = InMemoryDatabase
+
+	return opts
+}
+
+func (o *SQLiteOptions) Validate() error {
+	if o.Database == "" {
+		return fmt.Errorf("sqlite database cannot be empty")
+	}
+
+	// 内存数据库会强制使用单连接，无需校验连接池参数
+	if o.InMemory() {
+		return nil
+	}
+
+	// 验证连接池参数
+	if o.MaxIdleConnections <= 0 {
+		return fmt.Errorf("sqlite max idle connections must be greater than 0")
+	}
+
+	if o.MaxOpenConnections <= 0 {
+		return fmt.Errorf("sqlite max open connections must be greater than 0")
+	}
+
+	if o.MaxIdleConnections > o.MaxOpenConnections {
+		return fmt.Errorf("sqlite max idle connections cannot be greater than max open connections")
+	}
+
+	if o.MaxConnectionLifeTime <= 0 {
+		return fmt.Errorf("sqlite max connection lifetime must be greater than 0")
+	}
+
+	return nil
+}
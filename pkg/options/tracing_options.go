@@ -0,0 +1,150 @@
+package options
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"slices"
+
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.26.0"
+)
+
+// 支持的链路追踪导出方式.
+const (
+	// TracingExporterNone 不导出 span，但仍然会透传请求中的 traceparent.
+	TracingExporterNone = "none"
+	// TracingExporterOTLP 通过 OTLP 协议将 span 发送到 OpenTelemetry Collector 或兼容的后端.
+	TracingExporterOTLP = "otlp"
+	// TracingExporterStdout 将 span 以 JSON 格式输出到标准输出.
+	TracingExporterStdout = "stdout"
+	// TracingExporterFile 将 span 以 JSON 格式追加写入文件.
+	TracingExporterFile = "file"
+)
+
+// OTLP 导出使用的传输协议.
+const (
+	OTLPProtocolGRPC = "grpc"
+	OTLPProtocolHTTP = "http"
+)
+
+// TracingOptions 定义了 OpenTelemetry 链路追踪相关的配置.
+type TracingOptions struct {
+	// Exporter 是 span 的导出方式，可选值为 none、otlp、stdout、file.
+	Exporter string `json:"exporter" mapstructure:"exporter"`
+	// ServiceName 是上报的服务名称.
+	ServiceName string `json:"service-name" mapstructure:"service-name"`
+	// SampleRatio 是根 span 的采样比例，取值范围为 [0, 1]. 请求中携带了 traceparent 时遵循上游的采样决定.
+	SampleRatio float64 `json:"sample-ratio" mapstructure:"sample-ratio"`
+	// OTLPEndpoint 是 OTLP 接收端地址，例如 localhost:4317（grpc）或 localhost:4318（http）.
+	OTLPEndpoint string `json:"otlp-endpoint" mapstructure:"otlp-endpoint"`
+	// OTLPProtocol 是 OTLP 的传输协议，可选值为 grpc、http.
+	OTLPProtocol string `json:"otlp-protocol" mapstructure:"otlp-protocol"`
+	// OTLPInsecure 表示是否使用明文连接 OTLP 接收端.
+	OTLPInsecure bool `json:"otlp-insecure" mapstructure:"otlp-insecure"`
+	// File 是 exporter 为 file 时 span 的写入文件.
+	File string `json:"file" mapstructure:"file"`
+}
+
+// NewTracingOptions 创建一个带有默认值的 TracingOptions 实例.
+func NewTracingOptions() *TracingOptions {
+	return &TracingOptions{
+		Exporter:     TracingExporterNone,
+		ServiceName:  "fg-apiserver",
+		SampleRatio:  1,
+		OTLPEndpoint: "localhost:4317",
+		OTLPProtocol: OTLPProtocolGRPC,
+		OTLPInsecure: true,
+		File:         "traces.json",
+	}
+}
+
+// Validate 校验 TracingOptions 中的配置是否合法.
+func (o *TracingOptions) Validate() error {
+	exporters := []string{TracingExporterNone, TracingExporterOTLP, TracingExporterStdout, TracingExporterFile}
+	if !slices.Contains(exporters, o.Exporter) {
+		return fmt.Errorf("invalid tracing.exporter '%s', must be one of %v", o.Exporter, exporters)
+	}
+
+	if o.SampleRatio < 0 || o.SampleRatio > 1 {
+		return fmt.Errorf("tracing.sample-ratio must be between 0 and 1")
+	}
+
+	switch o.Exporter {
+	case TracingExporterOTLP:
+		protocols := []string{OTLPProtocolGRPC, OTLPProtocolHTTP}
+		if !slices.Contains(protocols, o.OTLPProtocol) {
+			return fmt.Errorf("invalid tracing.otlp-protocol '%s', must be one of %v", o.OTLPProtocol, protocols)
+		}
+		if o.OTLPEndpoint == "" {
+			return fmt.Errorf("tracing.otlp-endpoint cannot be empty when tracing.exporter is '%s'", TracingExporterOTLP)
+		}
+	case TracingExporterFile:
+		if o.File == "" {
+			return fmt.Errorf("tracing.file cannot be empty when tracing.exporter is '%s'", TracingExporterFile)
+		}
+	}
+
+	return nil
+}
+
+// Enabled 判断是否需要导出 span.
+func (o *TracingOptions) Enabled() bool {
+	return o.Exporter != TracingExporterNone
+}
+
+// NewTracerProvider 根据配置创建 TracerProvider. span 在后台批量导出，
+// 关闭服务时需要调用 Shutdown 导出剩余的 span.
+func (o *TracingOptions) NewTracerProvider(ctx context.Context, version string) (*sdktrace.TracerProvider, error) {
+	exporter, err := o.newExporter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(
+		semconv.SchemaURL,
+		semconv.ServiceName(o.ServiceName),
+		semconv.ServiceVersion(version),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	return sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(o.SampleRatio))),
+	), nil
+}
+
+func (o *TracingOptions) newExporter(ctx context.Context) (sdktrace.SpanExporter, error) {
+	switch o.Exporter {
+	case TracingExporterOTLP:
+		// OTLP 导出器在后台连接接收端，接收端不可用时不会阻塞服务启动
+		if o.OTLPProtocol == OTLPProtocolHTTP {
+			opts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(o.OTLPEndpoint)}
+			if o.OTLPInsecure {
+				opts = append(opts, otlptracehttp.WithInsecure())
+			}
+			return otlptracehttp.New(ctx, opts...)
+		}
+
+		opts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(o.OTLPEndpoint)}
+		if o.OTLPInsecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		return otlptracegrpc.New(ctx, opts...)
+	case TracingExporterFile:
+		f, err := os.OpenFile(o.File, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o644)
+		if err != nil {
+			return nil, err
+		}
+		return stdouttrace.New(stdouttrace.WithWriter(f))
+	default:
+		return stdouttrace.New(stdouttrace.WithPrettyPrint())
+	}
+}
@@ -0,0 +1,38 @@
+package options
+
+import (
+	"fmt"
+	"time"
+)
+
+// MinTrashPurgeInterval 是清理回收站的最小时间间隔，避免频繁扫描数据库.
+const MinTrashPurgeInterval = time.Minute
+
+// TrashOptions 定义了回收站相关的配置.
+type TrashOptions struct {
+	// Retention 是删除的用户和博客在回收站中的保留时间，超过后会被永久删除.
+	Retention time.Duration `json:"retention" mapstructure:"retention"`
+	// PurgeInterval 是后台任务检查并清理过期回收站记录的时间间隔.
+	PurgeInterval time.Duration `json:"purge-interval" mapstructure:"purge-interval"`
+}
+
+// NewTrashOptions 创建一个带有默认值的 TrashOptions 实例.
+func NewTrashOptions() *TrashOptions {
+	return &TrashOptions{
+		Retention:     30 * 24 * time.Hour,
+		PurgeInterval: time.Hour,
+	}
+}
+
+// Validate 校验 TrashOptions 中的配置是否合法.
+func (o *TrashOptions) Validate() error {
+	if o.Retention <= 0 {
+		return fmt.Errorf("trash.retention must be positive, got %s", o.Retention)
+	}
+
+	if o.PurgeInterval < MinTrashPurgeInterval {
+		return fmt.Errorf("trash.purge-interval must be at least %s, got %s", MinTrashPurgeInterval, o.PurgeInterval)
+	}
+
+	return nil
+}
@@ -0,0 +1,43 @@
+package options
+
+import (
+	"fmt"
+	"time"
+)
+
+// UserOptions 定义了用户账号相关的配置.
+type UserOptions struct {
+	// DeletionGracePeriod 是申请删除账号后的宽限期，宽限期内用户可以导出数据或取消删除.
+	// 为 0 时账号会在下一次清理时立即删除.
+	DeletionGracePeriod time.Duration `json:"deletion-grace-period" mapstructure:"deletion-grace-period"`
+	// AdminUsername 是初始管理员的用户名.
+	AdminUsername string `json:"admin-username" mapstructure:"admin-username"`
+	// AdminPassword 是初始管理员的密码. 不为空且系统中还没有管理员时，启动时使用该密码创建初始管理员.
+	AdminPassword string `json:"admin-password" mapstructure:"admin-password"`
+}
+
+// NewUserOptions 创建一个带有默认值的 UserOptions 实例.
+func NewUserOptions() *UserOptions {
+	return &UserOptions{
+		DeletionGracePeriod: 7 * 24 * time.Hour,
+		AdminUsername:       "root",
+	}
+}
+
+// Validate 校验 UserOptions 中的配置是否合法.
+func (o *UserOptions) Validate() error {
+	if o.DeletionGracePeriod < 0 {
+		return fmt.Errorf("user.deletion-grace-period cannot be negative, got %s", o.DeletionGracePeriod)
+	}
+
+	if o.AdminPassword != "" {
+		if o.AdminUsername == "" {
+			return fmt.Errorf("user.admin-username cannot be empty when user.admin-password is set")
+		}
+		if len(o.AdminPassword) < 8 || len(o.AdminPassword) > 64 {
+			return fmt.Errorf("user.admin-password must be between 8 and 64 characters")
+		}
+	}
+
+	return nil
+}
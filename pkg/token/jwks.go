@@ -0,0 +1,59 @@
+package token
+
+import (
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"math/big"
+	"sort"
+)
+
+// JWK 表示 RFC 7517 定义的 JSON Web Key，只包含公钥部分.
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	// N 和 E 是 RSA 公钥的模数和指数.
+	N string `json:"n,omitempty"`
+	E string `json:"e,omitempty"`
+	// Crv 和 X 是 Ed25519 公钥的曲线名称和公钥值.
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JWKSet 表示 JSON Web Key Set，其他服务可以使用它校验 fastgo 签发的 token.
+type JWKSet struct {
+	Keys []JWK `json:"keys"`
+}
+
+// JWKS 返回所有非对称校验密钥的公钥，HMAC 密钥永远不会被公开.
+func JWKS() *JWKSet {
+	set := &JWKSet{Keys: make([]JWK, 0, len(config.verificationKeys))}
+	for _, key := range config.verificationKeys {
+		if !key.IsAsymmetric() {
+			continue
+		}
+
+		jwk := JWK{Kid: key.ID, Use: "sig", Alg: key.Method.Alg()}
+		switch pub := key.verifyKey.(type) {
+		case *rsa.PublicKey:
+			jwk.Kty = "RSA"
+			jwk.N = base64.RawURLEncoding.EncodeToString(pub.N.Bytes())
+			jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pub.E)).Bytes())
+		case ed25519.PublicKey:
+			jwk.Kty = "OKP"
+			jwk.Crv = "Ed25519"
+			jwk.X = base64.RawURLEncoding.EncodeToString(pub)
+		default:
+			continue
+		}
+
+		set.Keys = append(set.Keys, jwk)
+	}
+
+	// 保证输出顺序稳定
+	sort.Slice(set.Keys, func(i, j int) bool { return set.Keys[i].Kid < set.Keys[j].Kid })
+
+	return set
+}
@@ -0,0 +1,182 @@
+package token
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+
+	jwt "github.com/golang-jwt/jwt/v4"
+)
+
+// ErrKeyNotFound 表示找不到与 token 中 kid 对应的校验密钥.
+var ErrKeyNotFound = errors.New("no verification key found for token")
+
+// Key 表示一个用于签发或校验 token 的密钥.
+type Key struct {
+	// ID 是密钥 ID，签发的 token 会在 kid 头部中携带该值.
+	ID string
+	// Method 是密钥对应的签名算法.
+	Method jwt.SigningMethod
+
+	// signKey 是签发 token 使用的密钥，只用于校验的密钥为 nil.
+	signKey any
+	// verifyKey 是校验 token 使用的密钥.
+	verifyKey any
+}
+
+// NewHMACKey 创建使用 HS256 算法的对称密钥.
+// HMAC 密钥不会出现在 JWKS 中，签发的 token 也不携带 kid.
+func NewHMACKey(secret string) *Key {
+	return &Key{
+		Method:    jwt.SigningMethodHS256,
+		signKey:   []byte(secret),
+		verifyKey: []byte(secret),
+	}
+}
+
+// LoadKeyFile 从 PEM 文件中加载 RSA 或 Ed25519 密钥.
+// 私钥既可以用于签发也可以用于校验，公钥只能用于校验.
+func LoadKeyFile(path string) (*Key, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	key, err := ParseKeyPEM(data)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse key file %s: %w", path, err)
+	}
+
+	return key, nil
+}
+
+// ParseKeyPEM 解析 PEM 格式的 RSA 或 Ed25519 密钥，密钥 ID 由公钥的 SHA-256 摘要生成.
+func ParseKeyPEM(data []byte) (*Key, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("no PEM data found")
+	}
+
+	var parsed any
+	var err error
+	switch block.Type {
+	case "RSA PRIVATE KEY":
+		parsed, err = x509.ParsePKCS1PrivateKey(block.Bytes)
+	case "PRIVATE KEY":
+		parsed, err = x509.ParsePKCS8PrivateKey(block.Bytes)
+	case "RSA PUBLIC KEY":
+		parsed, err = x509.ParsePKCS1PublicKey(block.Bytes)
+	case "PUBLIC KEY":
+		parsed, err = x509.ParsePKIXPublicKey(block.Bytes)
+	default:
+		return nil, fmt.Errorf("unsupported PEM block type %q", block.Type)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var key Key
+	switch k := parsed.(type) {
+	case *rsa.PrivateKey:
+		key = Key{Method: jwt.SigningMethodRS256, signKey: k, verifyKey: &k.PublicKey}
+	case *rsa.PublicKey:
+		key = Key{Method: jwt.SigningMethodRS256, verifyKey: k}
+	case ed25519.PrivateKey:
+		key = Key{Method: jwt.SigningMethodEdDSA, signKey: k, verifyKey: k.Public()}
+	case ed25519.PublicKey:
+		key = Key{Method: jwt.SigningMethodEdDSA, verifyKey: k}
+	default:
+		return nil, fmt.Errorf("unsupported key type %T, only RSA and Ed25519 keys are supported", parsed)
+	}
+
+	key.ID, err = keyID(key.verifyKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return &key, nil
+}
+
+// CanSign 判断密钥是否可以用于签发 token.
+func (k *Key) CanSign() bool {
+	return k.signKey != nil
+}
+
+// IsAsymmetric 判断密钥是否为非对称密钥，只有非对称密钥的公钥会通过 JWKS 公开.
+func (k *Key) IsAsymmetric() bool {
+	_, isHMAC := k.Method.(*jwt.SigningMethodHMAC)
+	return !isHMAC
+}
+
+// keyID 根据公钥的 DER 编码计算密钥 ID，同一个密钥在不同实例上得到的 ID 相同.
+func keyID(pub crypto.PublicKey) (string, error) {
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(der)
+	return hex.EncodeToString(sum[:8]), nil
+}
+
+// SetKeys 设置签发 token 使用的密钥和额外的校验密钥.
+// 签发密钥会自动加入校验密钥集合；密钥轮换时，将旧密钥作为校验密钥保留，旧 token 在过期前仍然有效.
+func SetKeys(signing *Key, verification ...*Key) error {
+	if signing == nil || !signing.CanSign() || !signing.IsAsymmetric() {
+		return errors.New("signing key must be an RSA or Ed25519 private key")
+	}
+
+	keys := map[string]*Key{signing.ID: signing}
+	for _, key := range verification {
+		if !key.IsAsymmetric() {
+			return errors.New("verification keys must be RSA or Ed25519 keys")
+		}
+		// 同一个密钥可能同时作为签发密钥和校验密钥配置，kid 相同时保留已有的密钥
+		if _, exists := keys[key.ID]; !exists {
+			keys[key.ID] = key
+		}
+	}
+
+	config.signingKey = signing
+	config.verificationKeys = keys
+
+	return nil
+}
+
+// currentSigningKey 返回当前用于签发 token 的密钥，未配置非对称密钥时使用 HMAC 密钥.
+func currentSigningKey() *Key {
+	if config.signingKey != nil {
+		return config.signingKey
+	}
+
+	return NewHMACKey(config.key)
+}
+
+// lookupKey 根据 token 头部中的 kid 和 alg 查找校验密钥.
+// 必须同时匹配 kid 和算法，防止攻击者用公钥作为 HMAC 密钥伪造 token.
+func lookupKey(token *jwt.Token) (any, error) {
+	kid, _ := token.Header["kid"].(string)
+
+	keys := config.verificationKeys
+	if keys == nil {
+		keys = map[string]*Key{"": NewHMACKey(config.key)}
+	}
+
+	key, ok := keys[kid]
+	if !ok {
+		return nil, ErrKeyNotFound
+	}
+
+	if token.Method.Alg() != key.Method.Alg() {
+		return nil, jwt.ErrSignatureInvalid
+	}
+
+	return key.verifyKey, nil
+}
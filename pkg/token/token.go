@@ -1,6 +1,11 @@
 package token
 
 import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
 	"errors"
 	"fmt"
 	"sync"
@@ -8,32 +13,72 @@ import (
 
 	"github.com/gin-gonic/gin"
 	jwt "github.com/golang-jwt/jwt/v4"
+	"github.com/google/uuid"
 )
 
+// DefaultKey 是编译在代码中的默认 HMAC 密钥，仅用于本地开发，生产环境必须替换.
+const DefaultKey = "Rtg8BPKNEf2mB4mgvKONGPZZQSaJWNLijxR42qRgq0iBb5"
+
 // Config 包括 token 包的配置选项.
 type Config struct {
 	// key 用于签发和解析 token 的密钥.
 	key string
+	// signingKey 是签发 token 使用的非对称密钥，为空时使用 key 作为 HMAC 密钥签发.
+	signingKey *Key
+	// verificationKeys 是按 kid 索引的校验密钥，为空时使用 key 作为 HMAC 密钥校验.
+	verificationKeys map[string]*Key
 	// identityKey 是 token 中用户身份的键.
 	identityKey string
 	// expiration 是签发的 token 过期时间
 	expiration time.Duration
+	// refreshExpiration 是刷新令牌的过期时间
+	refreshExpiration time.Duration
+	// issuer 是 token 的签发者（iss），不为空时解析 token 会校验该值
+	issuer string
+	// audience 是 token 的受众（aud），不为空时解析 token 会校验该值
+	audience string
+	// denylist 是访问令牌的吊销列表，为空时不检查吊销状态
+	denylist Denylist
+}
+
+// Claims 是从 token 中解析出的信息.
+type Claims struct {
+	// Identity 是 token 中的用户身份.
+	Identity string
+	// ID 是 token 的唯一 ID（jti）.
+	ID string
+	// SessionID 是 token 所属的登录会话 ID（sid）.
+	SessionID string
+	// ExpiresAt 是 token 的过期时间.
+	ExpiresAt time.Time
+}
+
+// Denylist 定义了访问令牌吊销列表需要实现的方法.
+type Denylist interface {
+	// IsRevoked 判断给定的令牌 ID（jti）或会话 ID（sid）中是否有已被吊销的.
+	IsRevoked(ctx context.Context, ids ...string) (bool, error)
 }
 
+// ErrTokenRevoked 表示 token 已被吊销.
+var ErrTokenRevoked = errors.New("token has been revoked")
+
 var (
 	config = Config{
 		// key 用于签发和解析 token 的密钥.
-		key: "Rtg8BPKNEf2mB4mgvKONGPZZQSaJWNLijxR42qRgq0iBb5",
+		key: DefaultKey,
 		// identityKey 是 token 中用户身份的键.
 		identityKey: "identityKey",
 		// expiration 是签发的 token 过期时间
 		expiration: 2 * time.Hour,
+		// refreshExpiration 是刷新令牌的过期时间
+		refreshExpiration: 7 * 24 * time.Hour,
 	}
 	// once 用于确保配置只初始化一次
 	once sync.Once
 )
 
-func Init(key string, identityKey string, expiration time.Duration) {
+// Init 设置 token 包的配置，只有第一次调用生效，为空的参数保持默认值.
+func Init(key string, identityKey string, expiration time.Duration, issuer string, audience string) {
 	once.Do(func() {
 		if key != "" {
 			config.key = key
@@ -46,71 +91,145 @@ func Init(key string, identityKey string, expiration time.Duration) {
 		if expiration != 0 {
 			config.expiration = expiration
 		}
+
+		config.issuer = issuer
+		config.audience = audience
 	})
 }
 
-func Parse(tokenString string, key string) (string, error) {
-	// 解析 token
-	token, err := jwt.Parse(tokenString, func(token *jwt.Token) (interface{}, error) {
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, jwt.ErrSignatureInvalid
-		}
+// SetDenylist 设置访问令牌的吊销列表，Parse 会拒绝已被吊销的 token.
+func SetDenylist(denylist Denylist) {
+	config.denylist = denylist
+}
 
-		return []byte(key), nil
-	})
+// Expiration 返回访问令牌的过期时间.
+func Expiration() time.Duration {
+	return config.expiration
+}
+
+// Parse 校验并解析 token，根据 token 头部中的 kid 选择校验密钥.
+func Parse(ctx context.Context, tokenString string) (*Claims, error) {
+	// 解析 token
+	token, err := jwt.Parse(tokenString, lookupKey)
 
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+
+	mapClaims, ok := token.Claims.(jwt.MapClaims)
+	if !ok || !token.Valid {
+		return nil, jwt.ErrTokenInvalidClaims
+	}
+
+	var claims Claims
+	claims.Identity, _ = mapClaims[config.identityKey].(string)
+	claims.ID, _ = mapClaims["jti"].(string)
+	claims.SessionID, _ = mapClaims["sid"].(string)
+	if exp, ok := mapClaims["exp"].(float64); ok {
+		claims.ExpiresAt = time.Unix(int64(exp), 0)
+	}
+
+	if claims.Identity == "" {
+		return nil, jwt.ErrTokenInvalidClaims
 	}
 
-	var identityKey string
-	if claims, ok := token.Claims.(jwt.MapClaims); ok && token.Valid {
-		if key, exists := claims[config.identityKey]; exists {
-			if identity, valid := key.(string); valid {
-				identityKey = identity
+	// 校验签发者和受众，防止接受其他服务签发的 token
+	if config.issuer != "" && !mapClaims.VerifyIssuer(config.issuer, true) {
+		return nil, jwt.ErrTokenInvalidIssuer
+	}
+	if config.audience != "" && !mapClaims.VerifyAudience(config.audience, true) {
+		return nil, jwt.ErrTokenInvalidAudience
+	}
+
+	// 检查 token 本身或其所属会话是否已被吊销
+	if config.denylist != nil {
+		var ids []string
+		for _, id := range []string{claims.ID, claims.SessionID} {
+			if id != "" {
+				ids = append(ids, id)
 			}
 		}
-	}
 
-	if identityKey == "" {
-		return "", jwt.ErrTokenInvalidClaims
+		if len(ids) > 0 {
+			revoked, err := config.denylist.IsRevoked(ctx, ids...)
+			if err != nil {
+				return nil, err
+			}
+			if revoked {
+				return nil, ErrTokenRevoked
+			}
+		}
 	}
 
-	return identityKey, nil
+	return &claims, nil
 }
 
-func ParseRequest(c *gin.Context) (string, error) {
+func ParseRequest(c *gin.Context) (*Claims, error) {
 	header := c.Request.Header.Get("Authorization")
 
 	if len(header) == 0 {
-		return "", errors.New("the length of the `Authorization` header is zero") // 返回错误
+		return nil, errors.New("the length of the `Authorization` header is zero") // 返回错误
 	}
 
 	var token string
 	fmt.Sscanf(header, "Bearer %s", &token)
 
-	return Parse(token, config.key)
+	return Parse(c.Request.Context(), token)
 }
 
-// Sign 使用 jwtSecret 签发 token，token 的 claims 中会存放传入的 subject.
-func Sign(identityKey string) (string, time.Time, error) {
+// Sign 使用当前的签发密钥签发 token，token 的 claims 中会存放传入的 subject 和登录会话 ID.
+func Sign(identityKey string, sessionID string) (string, time.Time, error) {
+	key := currentSigningKey()
+
 	// 计算过期时间
 	expireAt := time.Now().Add(config.expiration)
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.MapClaims{
+	token := jwt.NewWithClaims(key.Method, jwt.MapClaims{
 		config.identityKey: identityKey,
-		"nbf":              time.Now().Unix(), // token 生效时间
-		"iat":              time.Now().Unix(), // token 签发时间
-		"exp":              expireAt.Unix(),   // token 过期时间
+		"jti":              uuid.New().String(), // token 唯一 ID，用于吊销单个 token
+		"sid":              sessionID,           // token 所属的登录会话 ID，用于吊销整个会话
+		"nbf":              time.Now().Unix(),   // token 生效时间
+		"iat":              time.Now().Unix(),   // token 签发时间
+		"exp":              expireAt.Unix(),     // token 过期时间
 	})
 
-	if config.key == "" {
+	if config.issuer != "" {
+		token.Claims.(jwt.MapClaims)["iss"] = config.issuer
+	}
+	if config.audience != "" {
+		token.Claims.(jwt.MapClaims)["aud"] = config.audience
+	}
+
+	// 非对称密钥签发的 token 携带 kid，校验方据此从 JWKS 中选择公钥
+	if key.ID != "" {
+		token.Header["kid"] = key.ID
+	}
+
+	if !key.IsAsymmetric() && config.key == "" {
 		return "", time.Time{}, jwt.ErrInvalidKey
 	}
 
-	tokenString, err := token.SignedString([]byte(config.key))
+	tokenString, err := token.SignedString(key.signKey)
 	if err != nil {
 		return "", time.Time{}, err
 	}
 
 	return tokenString, expireAt, nil
 }
+
+// NewRefreshToken 生成一个随机的不透明刷新令牌，返回令牌明文、令牌哈希值和过期时间.
+// 服务端只保存哈希值，明文只返回给客户端一次.
+func NewRefreshToken() (string, string, time.Time, error) {
+	buf := make([]byte, 32)
+	if _, err := rand.Read(buf); err != nil {
+		return "", "", time.Time{}, err
+	}
+
+	raw := base64.RawURLEncoding.EncodeToString(buf)
+	return raw, HashRefreshToken(raw), time.Now().Add(config.refreshExpiration), nil
+}
+
+// HashRefreshToken 计算刷新令牌的 SHA-256 哈希值.
+func HashRefreshToken(raw string) string {
+	sum := sha256.Sum256([]byte(raw))
+	return hex.EncodeToString(sum[:])
+}
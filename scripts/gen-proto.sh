@@ -0,0 +1,17 @@
+#!/usr/bin/env bash
+
+# 根据 pkg/api/apiserver/v1/pb 下的 .proto 文件生成 Go 代码.
+# 依赖 protoc、protoc-gen-go 和 protoc-gen-go-grpc，插件可以通过以下命令安装：
+#   go install google.golang.org/protobuf/cmd/protoc-gen-go@v1.36.6
+#   go install google.golang.org/grpc/cmd/protoc-gen-go-grpc@v1.5.1
+set -o errexit
+set -o nounset
+set -o pipefail
+
+PROJ_ROOT=$(dirname "${BASH_SOURCE[0]}")/..
+PROTO_DIR=${PROJ_ROOT}/pkg/api/apiserver/v1/pb
+
+protoc --proto_path="${PROTO_DIR}" \
+  --go_out="${PROTO_DIR}" --go_opt=paths=source_relative \
+  --go-grpc_out="${PROTO_DIR}" --go-grpc_opt=paths=source_relative \
+  "${PROTO_DIR}"/*.proto
@@ -46,7 +46,7 @@ fg::test::user()
   token="-HAuthorization: Bearer $(fg::test::login ${username} fastgo1234)"
 
   # 2. 列出所有用户
-  ${RCURL} "${token}" "http://${INSECURE_SERVER}/v1/users?offset=0&limit=10"; echo
+  ${RCURL} "${token}" "http://${INSECURE_SERVER}/v1/users?limit=10&withTotal=true"; echo
   echo -e "\033[32m2. 成功列出所有用户\033[0m"
 
   # 3. 获取 fastgo 用户的详细信息